@@ -2,7 +2,11 @@ package gsheets
 
 import (
 	"context"
+	"fmt"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/gertd/go-pluralize"
 	"google.golang.org/api/sheets/v4"
@@ -22,11 +26,39 @@ type config struct {
 	sheetName        string
 	tagName          string
 	datetimeFormats  []string
+	customFormats    []string
 	allowSkipFields  bool
 	allowSkipColumns bool
+	allowChildren    bool
+	multipleTypes    bool
+	collectRowErrors bool
+	errorBudget      bool
+	maxErrors        int
+	maxErrorRatio    float64
+	duplicateColumns DuplicateColumns
+	headerMatcher    HeaderMatcher
+	headerless       bool
+	headerRows       int
+	majorDimension   MajorDimension
+	cellOffset       int
+	recordOffset     int
+	skipBlankHeaders bool
+	tableAnchors     []string
+	tableEnd         TableEnd
+	stopAtBlankRow   bool
+	stopAt           RowPredicate
+	maxRows          int
+	keepBlankRows    bool
+	commentPrefix    string
+	rowFilter        RowPredicate
+	fillDown         bool
+	fillDownMerges   bool
+	merges           []*sheets.GridRange
 	built            bool
 	ctx              context.Context
 	fetch            fetchFN
+	batchFetch       batchFetchFN
+	fetchMerges      fetchMergesFN
 }
 
 // MakeConfig creates a new Config with the given Google Sheets service and arbitrary options.
@@ -35,6 +67,8 @@ func MakeConfig(svc *sheets.Service, spreadsheetID string, opts ...ConfigOption)
 		spreadsheetID: spreadsheetID,
 		tagName:       defaultTag,
 		fetch:         fetchViaGoogleAPI,
+		batchFetch:    batchFetchViaGoogleAPI,
+		fetchMerges:   fetchMergesViaGoogleAPI,
 	}
 
 	for _, modify := range opts {
@@ -84,7 +118,7 @@ func WithTagName(name string) ConfigOption {
 // WithDatetimeFormats allows to define additional date-time formats to be recognized during the parsing.
 func WithDatetimeFormats(formats ...string) ConfigOption {
 	return func(c *config) {
-		c.datetimeFormats = formats
+		c.customFormats = formats
 	}
 }
 
@@ -104,6 +138,234 @@ func WithAllowSkipColumns(allow bool) ConfigOption {
 	}
 }
 
+// WithHeaderMatcher defines how header captions are matched against the column names of the struct fields.
+// Built-in strategies are MatchExact (default), MatchCaseInsensitive and MatchNormalized.
+func WithHeaderMatcher(matcher HeaderMatcher) ConfigOption {
+	return func(c *config) {
+		c.headerMatcher = matcher
+	}
+}
+
+// WithHeaderless defines that the sheet has no header row at all.
+// Fields are then mapped by the column letter or 1-based index given in their tag, e.g. `gsheets:",col=C"` or
+// `gsheets:",col=3"`, and parsing starts at the first row. Fields without a column are treated as not found.
+func WithHeaderless(headerless bool) ConfigOption {
+	return func(c *config) {
+		c.headerless = headerless
+	}
+}
+
+// MajorDimension defines whether the records of a sheet are laid out in rows or in columns.
+type MajorDimension string
+
+const (
+	// MajorDimensionRows lays out one record per row, with the header in the first row. This is the default.
+	MajorDimensionRows MajorDimension = "ROWS"
+	// MajorDimensionColumns lays out one record per column, with the header in the first column.
+	MajorDimensionColumns MajorDimension = "COLUMNS"
+)
+
+// WithMajorDimension defines whether the records of a sheet are laid out in rows or in columns.
+// With MajorDimensionColumns, transposed sheets can be parsed with the same struct mapping,
+// while cells in errors still point to the actual A1 cell. The index yielded by ParseSheetIntoStructs is then the
+// 1-based column number of the record.
+func WithMajorDimension(dim MajorDimension) ConfigOption {
+	return func(c *config) {
+		c.majorDimension = dim
+	}
+}
+
+// WithHeaderRows defines the number of header rows of the sheet, e.g. 2 for grouped headers.
+// The caption of a group, e.g. a merged "Billing" cell above "Street", "City" and "Zip", is mapped to a named nested
+// struct field, while the captions below are mapped to the fields of the nested struct.
+// Embedded structs do not form a group. The default is a single header row.
+func WithHeaderRows(n int) ConfigOption {
+	return func(c *config) {
+		c.headerRows = n
+	}
+}
+
+// WithSkipBlankHeaders allows blank header cells, e.g. of spacer columns, to be skipped.
+// The header row is then scanned up to the end of the table, instead of stopping at the first blank header cell.
+func WithSkipBlankHeaders(skip bool) ConfigOption {
+	return func(c *config) {
+		c.skipBlankHeaders = skip
+	}
+}
+
+// TableEnd determines the number of columns of a table from its header captions. Negative values are treated as 0.
+type TableEnd func(captions []string) int
+
+// TableEndFirstBlank ends the table at the first blank header cell.
+func TableEndFirstBlank(captions []string) int {
+	if idx := slices.Index(captions, ""); idx >= 0 {
+		return idx
+	}
+	return len(captions)
+}
+
+// TableEndLastHeader ends the table after the last non-empty header cell. This is the default.
+func TableEndLastHeader(captions []string) int {
+	for idx := len(captions) - 1; idx >= 0; idx-- {
+		if captions[idx] != "" {
+			return idx + 1
+		}
+	}
+	return 0
+}
+
+// TableEndWidth ends the table after the given number of columns.
+func TableEndWidth(n int) TableEnd {
+	return func([]string) int {
+		return n
+	}
+}
+
+// WithTableEnd defines where the table ends. Columns beyond the end of the table are ignored.
+// Unless blank header cells are skipped, the table still ends at the first blank header cell.
+func WithTableEnd(end TableEnd) ConfigOption {
+	return func(c *config) {
+		c.tableEnd = end
+	}
+}
+
+// WithTableAnchors defines the titles of the tables within a sheet, which is parsed via ParseSheetIntoTables.
+// A table then starts below the row whose first cell matches one of the anchors, as determined by the header matcher,
+// and ends before the next anchor. Rows above the first anchor are ignored.
+func WithTableAnchors(anchors ...string) ConfigOption {
+	return func(c *config) {
+		c.tableAnchors = anchors
+	}
+}
+
+// RowPredicate reports whether a row matches a condition, given its 1-based row number and its cells.
+type RowPredicate func(rowIdx int, cells []string) bool
+
+// FirstCellEquals matches rows, whose first cell equals one of the given values, e.g. "Total".
+func FirstCellEquals(values ...string) RowPredicate {
+	return func(_ int, cells []string) bool {
+		return len(cells) > 0 && slices.Contains(values, cells[0])
+	}
+}
+
+// WithStopAtBlankRow ends the table at the first fully blank row, e.g. to ignore notes below the table.
+func WithStopAtBlankRow(stop bool) ConfigOption {
+	return func(c *config) {
+		c.stopAtBlankRow = stop
+	}
+}
+
+// WithStopAt ends the table at the first row matching the given predicate, e.g. a totals row.
+// The matching row itself is not parsed.
+func WithStopAt(stop RowPredicate) ConfigOption {
+	return func(c *config) {
+		c.stopAt = stop
+	}
+}
+
+// WithMaxRows ends the table after the given number of data rows.
+func WithMaxRows(n int) ConfigOption {
+	return func(c *config) {
+		c.maxRows = n
+	}
+}
+
+// WithSkipBlankRows defines whether fully blank rows within the table are skipped. This is the default.
+// If this is set to false, blank rows are converted into zero values, or fail for required fields.
+func WithSkipBlankRows(skip bool) ConfigOption {
+	return func(c *config) {
+		c.keepBlankRows = !skip
+	}
+}
+
+// WithCommentPrefix skips rows, whose first cell starts with the given prefix, e.g. "#".
+func WithCommentPrefix(prefix string) ConfigOption {
+	return func(c *config) {
+		c.commentPrefix = prefix
+	}
+}
+
+// WithRowFilter skips rows, for which the given filter returns false. It's evaluated before the row is converted.
+func WithRowFilter(filter RowPredicate) ConfigOption {
+	return func(c *config) {
+		c.rowFilter = filter
+	}
+}
+
+// WithFillDown carries the last non-empty value of each column down to the empty cells below it,
+// e.g. for vertically merged or visually grouped cells, for which the API returns the value only in the first row.
+// Single fields can be filled down via the `filldown` tag option instead, e.g. `gsheets:"Category,filldown"`.
+func WithFillDown(fill bool) ConfigOption {
+	return func(c *config) {
+		c.fillDown = fill
+	}
+}
+
+// WithFillDownMerges fills the empty cells of merged cells with the value of the merge's top-left cell.
+// The merge ranges are fetched from the metadata of the spreadsheet, with an additional API call.
+func WithFillDownMerges(fill bool) ConfigOption {
+	return func(c *config) {
+		c.fillDownMerges = fill
+	}
+}
+
+// DuplicateColumns defines how columns sharing the same header are handled.
+type DuplicateColumns int
+
+const (
+	// DuplicateColumnsError raises a *DuplicateColumnError, if a duplicate column is mapped to a field. This is the default.
+	DuplicateColumnsError DuplicateColumns = iota
+	// DuplicateColumnsFirst maps the first occurrence of a duplicate column.
+	DuplicateColumnsFirst
+	// DuplicateColumnsLast maps the last occurrence of a duplicate column.
+	DuplicateColumnsLast
+	// DuplicateColumnsSlice maps all occurrences of a duplicate column positionally into a slice field.
+	// Slice fields are only supported in this mode.
+	// Duplicate columns mapped to a non-slice field still raise a *DuplicateColumnError.
+	DuplicateColumnsSlice
+)
+
+// WithDuplicateColumns defines how columns sharing the same header are handled.
+func WithDuplicateColumns(handling DuplicateColumns) ConfigOption {
+	return func(c *config) {
+		c.duplicateColumns = handling
+	}
+}
+
+// WithCollectRowErrors allows to convert all cells of a row, even if one of them fails.
+// All failures of the row are then reported together as a *RowError.
+// If this is set to false, the first failing cell aborts the row with a *MappingError.
+func WithCollectRowErrors(collect bool) ConfigOption {
+	return func(c *config) {
+		c.collectRowErrors = collect
+	}
+}
+
+// WithMaxErrors defines an error budget of n erroneous rows for the APIs returning slices, i.e.
+// ParseSheetIntoStructSlice, ParseSheetWithReport, ParseSheetIntoGroups, ParseSheetIntoUnionSlice,
+// ParseTableIntoStructSlice, ParseSheetIntoMapSlice and ParseSpreadsheet.
+// Rows with errors are skipped, and parsing is aborted with an *ErrorBudgetError as soon as more than n rows failed.
+// If rows were skipped within the budget, their errors are returned as *SkippedRowsError along with the parsed rows.
+// If combined with WithMaxErrorRatio, the more generous budget wins.
+// The budget is ignored by the iterator APIs, e.g. ParseSheetIntoStructs, which yield each error as a Result.
+func WithMaxErrors(n int) ConfigOption {
+	return func(c *config) {
+		c.errorBudget = true
+		c.maxErrors = n
+	}
+}
+
+// WithMaxErrorRatio defines an error budget relative to the number of converted data rows in the sheet,
+// e.g. 0.01 tolerates up to 1% erroneous rows. Blank, comment and filtered rows don't count.
+// As the number of rows is only known at the end, the budget is checked after all rows were converted.
+// See WithMaxErrors for details.
+func WithMaxErrorRatio(ratio float64) ConfigOption {
+	return func(c *config) {
+		c.errorBudget = true
+		c.maxErrorRatio = ratio
+	}
+}
+
 // withFetch is for testing purposes only, and allows to mock the call to the Google Sheets API.
 func withFetch(fetch fetchFN) ConfigOption {
 	return func(c *config) {
@@ -111,6 +373,20 @@ func withFetch(fetch fetchFN) ConfigOption {
 	}
 }
 
+// withBatchFetch is for testing purposes only, and allows to mock the batch call to the Google Sheets API.
+func withBatchFetch(fetch batchFetchFN) ConfigOption {
+	return func(c *config) {
+		c.batchFetch = fetch
+	}
+}
+
+// withFetchMerges is for testing purposes only, and allows to mock the call for the merged cells to the Google Sheets API.
+func withFetchMerges(fetch fetchMergesFN) ConfigOption {
+	return func(c *config) {
+		c.fetchMerges = fetch
+	}
+}
+
 func (c Config) init(ref reflect.Type, opts []ConfigOption) (Config, error) {
 	if len(opts) > 0 {
 		for _, modify := range opts {
@@ -134,19 +410,130 @@ func (c Config) init(ref reflect.Type, opts []ConfigOption) (Config, error) {
 	if c.fetch == nil {
 		c.fetch = fetchViaGoogleAPI
 	}
+	if c.batchFetch == nil {
+		c.batchFetch = batchFetchViaGoogleAPI
+	}
+	if c.fetchMerges == nil {
+		c.fetchMerges = fetchMergesViaGoogleAPI
+	}
 	if c.tagName == "" {
 		c.tagName = defaultTag
 	}
+	if c.majorDimension == "" {
+		c.majorDimension = MajorDimensionRows
+	}
+	if c.majorDimension != MajorDimensionRows && c.majorDimension != MajorDimensionColumns {
+		return c, fmt.Errorf("%w: %q", ErrInvalidMajorDimension, c.majorDimension)
+	}
+	if c.headerRows < 1 {
+		c.headerRows = 1
+	}
+	if c.tableEnd == nil {
+		c.tableEnd = TableEndLastHeader
+	}
+	if c.headerMatcher == nil {
+		c.headerMatcher = MatchExact
+	}
 	if c.sheetName == "" {
+		// without a type to derive the name from, it must be given explicitly
+		if ref == nil {
+			return c, ErrNoSheetName
+		}
 		c.sheetName = pluralizeClient.Plural(ref.Name())
 	}
 
-	c.datetimeFormats = append(c.datetimeFormats, dateTimeFormats[:]...)
+	// the formats are rebuilt on each init, as a Config may be initialized again with further options
+	c.datetimeFormats = append(slices.Clone(c.customFormats), dateTimeFormats[:]...)
 
 	c.built = true
 	return c, nil
 }
 
+// allowedErrors returns the number of erroneous rows to be tolerated for a sheet with the given amount of
+// converted rows.
+func (c *config) allowedErrors(rows int) int {
+	return max(c.maxErrors, int(c.maxErrorRatio*float64(rows)))
+}
+
+// checkErrorBudget returns an *ErrorBudgetError, if the errors of the failed rows exceed the error budget,
+// with rows being the number of rows converted so far. Until all rows are converted, i.e. if !done,
+// only the fixed budget of WithMaxErrors is checked, as the ratio depends on the final number of rows.
+// Once all rows are converted, the errors within the budget are returned as *SkippedRowsError.
+func (c *config) checkErrorBudget(errs []error, rows int, done bool) error {
+	if len(errs) == 0 || (!done && c.maxErrorRatio > 0) {
+		return nil
+	}
+
+	if allowed := c.allowedErrors(rows); len(errs) > allowed {
+		return &ErrorBudgetError{Sheet: c.sheetName, Rows: rows, Allowed: allowed, errs: errs}
+	}
+	if !done {
+		return nil
+	}
+	return &SkippedRowsError{Sheet: c.sheetName, Rows: rows, errs: errs}
+}
+
+// tableLength returns the number of the given data rows, which belong to the table, as determined by the stop options.
+// The offset is added to the index of a row to get its 1-based row number.
+func (c *config) tableLength(data [][]any, offset int) int {
+	n := len(data)
+	if c.maxRows > 0 {
+		n = min(n, c.maxRows)
+	}
+
+	for i, row := range data[:n] {
+		if c.stopAtBlankRow && isBlankRow(row) || c.stopAt != nil && c.stopAt(i+offset, rowCells(row)) {
+			return i
+		}
+	}
+	return n
+}
+
+// skipRow reports whether the given row is to be skipped, i.e. it's blank, a comment, or rejected by the row filter.
+func (c *config) skipRow(rowIdx int, row []any) bool {
+	if !c.keepBlankRows && isBlankRow(row) {
+		return true
+	}
+	if c.commentPrefix != "" && len(row) > 0 && strings.HasPrefix(row[0].(string), c.commentPrefix) {
+		return true
+	}
+	return c.rowFilter != nil && !c.rowFilter(rowIdx, rowCells(row))
+}
+
+// columnName returns the name of the header position with the given 0-based index,
+// i.e. the column letter, or the row number for sheets laid out in columns.
+func (c *config) columnName(idx int) string {
+	if c.majorDimension == MajorDimensionColumns {
+		return strconv.Itoa(idx + 1 + c.cellOffset)
+	}
+	return columnName(idx + c.cellOffset)
+}
+
+// cellName returns the A1 notation of a cell, given its 0-based index within the record and the 1-based record index,
+// i.e. the row number, or the column number for sheets laid out in columns.
+func (c *config) cellName(idx, recordIdx int) string {
+	row, col := c.cellIndex(idx, recordIdx)
+	return columnName(col) + strconv.Itoa(row+1)
+}
+
+// cellIndex returns the 0-based row and column index of a cell within the sheet, given its 0-based index within the
+// record and the 1-based record index. The cell offset is added to the index within the record.
+func (c *config) cellIndex(idx, recordIdx int) (int, int) {
+	if c.majorDimension == MajorDimensionColumns {
+		return idx + c.cellOffset, recordIdx - 1
+	}
+	return recordIdx - 1, idx + c.cellOffset
+}
+
+// headerKey returns the key of a column with the given caption path, as determined by the header matcher.
+func (c *config) headerKey(path ...string) string {
+	keys := make([]string, 0, len(path))
+	for _, caption := range path {
+		keys = append(keys, c.headerMatcher(caption))
+	}
+	return strings.Join(keys, "\x00")
+}
+
 // Context returns the configured context, or creates a new background context
 func (c *config) Context() context.Context {
 	if c.ctx == nil {
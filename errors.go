@@ -12,14 +12,42 @@ var (
 	ErrNoService = errors.New("gsheets: no Google API service registered")
 	// ErrNoSpreadSheetID is returned when no spreadsheet ID is provided to the parse call.
 	ErrNoSpreadSheetID = errors.New("gsheets: no spreadsheet id provided")
+	// ErrNoSheetName is returned when no sheet name is provided to a parse call, which cannot derive it from a type.
+	ErrNoSheetName = errors.New("gsheets: no sheet name provided")
 	// ErrUnsupportedType is returned when the type of field is not supported.
 	ErrUnsupportedType = errors.New("gsheets: unsupported type")
 	// ErrNoMapping is returned when not a single field mapping is found.
 	ErrNoMapping = errors.New("gsheets: no mapping found")
+	// ErrNoRecord is returned when a key/value sheet doesn't yield a record.
+	ErrNoRecord = errors.New("gsheets: no record found")
 	// ErrFieldNotFoundInSheet is returned when a field is not found in the sheet.
 	ErrFieldNotFoundInSheet = errors.New("gsheets: field not found in sheet")
 	// ErrFieldNotFoundInStruct is returned when a field/column is not found in the struct.
 	ErrFieldNotFoundInStruct = errors.New("gsheets: field not found in struct")
+	// ErrRequiredValue is returned when the cell of a required field is empty.
+	ErrRequiredValue = errors.New("gsheets: missing value for required field")
+	// ErrDuplicateColumn is returned when a header is found in multiple columns of the sheet.
+	ErrDuplicateColumn = errors.New("gsheets: duplicate column")
+	// ErrInvalidTag is returned when the tag of a field contains an invalid option.
+	ErrInvalidTag = errors.New("gsheets: invalid tag")
+	// ErrAmbiguousColumn is returned when the pattern of a field matches multiple different columns of the sheet.
+	ErrAmbiguousColumn = errors.New("gsheets: ambiguous column")
+	// ErrInvalidColumnPattern is returned when the regular expression of a tag cannot be compiled.
+	ErrInvalidColumnPattern = errors.New("gsheets: invalid column pattern")
+	// ErrErrorBudgetExceeded is returned when more rows failed than the configured error budget allows.
+	ErrErrorBudgetExceeded = errors.New("gsheets: error budget exceeded")
+	// ErrRowsSkipped is returned along with the parsed rows, when erroneous rows were skipped within the error budget.
+	ErrRowsSkipped = errors.New("gsheets: erroneous rows skipped")
+	// ErrNoParentRow is returned when a child row is not preceded by a parent row.
+	ErrNoParentRow = errors.New("gsheets: child row without parent row")
+	// ErrUnknownDiscriminator is returned when the discriminator column of a row holds a value without registered type.
+	ErrUnknownDiscriminator = errors.New("gsheets: unknown discriminator value")
+	// ErrInvalidMajorDimension is returned when the major dimension is neither MajorDimensionRows nor MajorDimensionColumns.
+	ErrInvalidMajorDimension = errors.New("gsheets: invalid major dimension")
+	// ErrInvalidSchema is returned when a Schema defines an invalid column.
+	ErrInvalidSchema = errors.New("gsheets: invalid schema")
+	// ErrInvalidValue is returned when the value of a cell is rejected by a validator of its Schema column.
+	ErrInvalidValue = errors.New("gsheets: invalid value")
 )
 
 // InvalidDateTimeFormatError is returned when an invalid datetime format is encountered.
@@ -48,17 +76,172 @@ func (e *ConvertError) Unwrap() error {
 }
 
 // MappingError is returned when an error is encountered during the mapping.
+// Header holds the caption of the column, which actually matched the field.
 type MappingError struct {
-	Sheet string
-	Cell  string
-	Field string
-	err   error
+	Sheet  string
+	Cell   string
+	Field  string
+	Header string
+	Value  string
+	err    error
 }
 
 func (e *MappingError) Error() string {
-	return fmt.Sprintf("%s\n\tsheet: %q\n\tcell: %q\n\tfield: %q", e.err, e.Sheet, e.Cell, e.Field)
+	msg := fmt.Sprintf("%s\n\tsheet: %q\n\tcell: %q\n\tfield: %q", e.err, e.Sheet, e.Cell, e.Field)
+	if e.Header != "" {
+		msg += fmt.Sprintf("\n\theader: %q", e.Header)
+	}
+	return msg
 }
 
 func (e *MappingError) Unwrap() error {
 	return e.err
 }
+
+// RowError is returned when the collection of row errors is enabled, and one or more cells of a row could not be mapped.
+// It holds a *MappingError for each failing cell.
+type RowError struct {
+	Sheet string
+	Row   int
+	errs  []error
+}
+
+func (e *RowError) Error() string {
+	msgs := make([]string, 0, len(e.errs))
+	for _, err := range e.errs {
+		msgs = append(msgs, strings.ReplaceAll(err.Error(), "\n", "\n\t"))
+	}
+	return fmt.Sprintf("gsheets: %d error(s) in row %d of sheet %q:\n\t%s", len(e.errs), e.Row, e.Sheet, strings.Join(msgs, "\n\t"))
+}
+
+func (e *RowError) Unwrap() []error {
+	return e.errs
+}
+
+// ErrorBudgetError is returned when more rows failed than the configured error budget allows.
+// It holds the errors of all failed rows up to the point where parsing was aborted.
+type ErrorBudgetError struct {
+	Sheet   string
+	Rows    int
+	Allowed int
+	errs    []error
+}
+
+func (e *ErrorBudgetError) Error() string {
+	return fmt.Sprintf("%s: more than %d of %d rows failed in sheet %q\n%s", ErrErrorBudgetExceeded, e.Allowed, e.Rows, e.Sheet, errors.Join(e.errs...))
+}
+
+func (e *ErrorBudgetError) Is(target error) bool {
+	return target == ErrErrorBudgetExceeded
+}
+
+func (e *ErrorBudgetError) Unwrap() []error {
+	return e.errs
+}
+
+// SkippedRowsError is returned along with the parsed rows, when erroneous rows were skipped within the error budget.
+// It's not fatal, and holds the errors of all skipped rows.
+type SkippedRowsError struct {
+	Sheet string
+	Rows  int
+	errs  []error
+}
+
+func (e *SkippedRowsError) Error() string {
+	return fmt.Sprintf("%s: %d of %d rows failed in sheet %q\n%s", ErrRowsSkipped, len(e.errs), e.Rows, e.Sheet, errors.Join(e.errs...))
+}
+
+func (e *SkippedRowsError) Is(target error) bool {
+	return target == ErrRowsSkipped
+}
+
+func (e *SkippedRowsError) Unwrap() []error {
+	return e.errs
+}
+
+// SheetError is returned by ParseSpreadsheet, when a single sheet of the spreadsheet could not be parsed.
+type SheetError struct {
+	Sheet string
+	err   error
+}
+
+func (e *SheetError) Error() string {
+	return fmt.Sprintf("gsheets: failed to parse sheet %q: %s", e.Sheet, e.err)
+}
+
+func (e *SheetError) Unwrap() error {
+	return e.err
+}
+
+// SchemaMismatchError is returned when the columns of a sheet do not match the fields of the struct.
+// Missing fields are listed in the order of the struct, unknown columns are sorted by their column index.
+type SchemaMismatchError struct {
+	Sheet          string
+	MissingFields  []MissingField
+	UnknownColumns []UnknownColumn
+}
+
+// MissingField describes a struct field, for which no column was found in the sheet.
+type MissingField struct {
+	Field string
+	Name  string
+}
+
+// UnknownColumn describes a column of the sheet, which could not be mapped to a struct field.
+type UnknownColumn struct {
+	Name   string
+	Column string
+	Index  int
+}
+
+func (e *SchemaMismatchError) Error() string {
+	return errors.Join(e.Unwrap()...).Error()
+}
+
+func (e *SchemaMismatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.MissingFields)+len(e.UnknownColumns))
+	for _, f := range e.MissingFields {
+		errs = append(errs, fmt.Errorf("%w: %q", ErrFieldNotFoundInSheet, f.Name))
+	}
+	for _, c := range e.UnknownColumns {
+		errs = append(errs, fmt.Errorf("%w: %q in column %q", ErrFieldNotFoundInStruct, c.Name, c.Column))
+	}
+	return errs
+}
+
+// DuplicateColumnError is returned when a field is mapped to a header, which is found in multiple columns of the sheet.
+type DuplicateColumnError struct {
+	Sheet   string
+	Name    string
+	Columns []string
+	Indexes []int
+}
+
+func (e *DuplicateColumnError) Error() string {
+	return fmt.Sprintf("%s: %q in columns [\"%s\"] of sheet %q", ErrDuplicateColumn, e.Name, strings.Join(e.Columns, `", "`), e.Sheet)
+}
+
+func (e *DuplicateColumnError) Is(target error) bool {
+	return target == ErrDuplicateColumn
+}
+
+// AmbiguousColumnError is returned when the pattern of a field matches multiple different columns of the sheet.
+type AmbiguousColumnError struct {
+	Sheet   string
+	Field   string
+	Headers []string
+	Columns []string
+	Indexes []int
+}
+
+func (e *AmbiguousColumnError) Error() string {
+	cols := make([]string, 0, len(e.Headers))
+	for i, header := range e.Headers {
+		cols = append(cols, fmt.Sprintf("%q (%s)", header, e.Columns[i]))
+	}
+	return fmt.Sprintf("%s: field %q matches columns [%s] of sheet %q", ErrAmbiguousColumn, e.Field, strings.Join(cols, ", "), e.Sheet)
+}
+
+func (e *AmbiguousColumnError) Is(target error) bool {
+	return target == ErrAmbiguousColumn
+}
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConvertError_Error(t *testing.T) {
@@ -54,3 +55,143 @@ func TestMappingError_Unwrap(t *testing.T) {
 	err := &MappingError{err: expectedErr}
 	assert.Equal(t, expectedErr, err.Unwrap())
 }
+
+func TestRowError_Error(t *testing.T) {
+	err := &RowError{
+		Sheet: "test",
+		Row:   2,
+		errs: []error{
+			&MappingError{Sheet: "test", Cell: "A2", Field: "Type.Field1", err: errors.New("inner error 1")},
+			&MappingError{Sheet: "test", Cell: "B2", Field: "Type.Field2", err: errors.New("inner error 2")},
+		},
+	}
+
+	const msg = `gsheets: 2 error(s) in row 2 of sheet "test":
+	inner error 1
+		sheet: "test"
+		cell: "A2"
+		field: "Type.Field1"
+	inner error 2
+		sheet: "test"
+		cell: "B2"
+		field: "Type.Field2"`
+
+	assert.Equal(t, msg, err.Error())
+}
+
+func TestRowError_Unwrap(t *testing.T) {
+	expectedErrs := []error{errors.New("inner error 1"), errors.New("inner error 2")}
+	err := &RowError{errs: expectedErrs}
+	assert.Equal(t, expectedErrs, err.Unwrap())
+}
+
+func TestErrorBudgetError_Error(t *testing.T) {
+	err := &ErrorBudgetError{
+		Sheet:   "test",
+		Rows:    10,
+		Allowed: 1,
+		errs:    []error{errors.New("inner error 1"), errors.New("inner error 2")},
+	}
+
+	const msg = `gsheets: error budget exceeded: more than 1 of 10 rows failed in sheet "test"
+inner error 1
+inner error 2`
+
+	assert.Equal(t, msg, err.Error())
+	assert.ErrorIs(t, err, ErrErrorBudgetExceeded)
+}
+
+func TestErrorBudgetError_Unwrap(t *testing.T) {
+	expectedErrs := []error{errors.New("inner error 1"), errors.New("inner error 2")}
+	err := &ErrorBudgetError{errs: expectedErrs}
+	assert.Equal(t, expectedErrs, err.Unwrap())
+}
+
+func TestSkippedRowsError_Error(t *testing.T) {
+	err := &SkippedRowsError{
+		Sheet: "test",
+		Rows:  10,
+		errs:  []error{errors.New("inner error 1"), errors.New("inner error 2")},
+	}
+
+	const msg = `gsheets: erroneous rows skipped: 2 of 10 rows failed in sheet "test"
+inner error 1
+inner error 2`
+
+	assert.Equal(t, msg, err.Error())
+	assert.ErrorIs(t, err, ErrRowsSkipped)
+	assert.NotErrorIs(t, err, ErrErrorBudgetExceeded)
+}
+
+func TestSkippedRowsError_Unwrap(t *testing.T) {
+	expectedErrs := []error{errors.New("inner error 1"), errors.New("inner error 2")}
+	err := &SkippedRowsError{errs: expectedErrs}
+	assert.Equal(t, expectedErrs, err.Unwrap())
+}
+
+func TestSchemaMismatchError_Error(t *testing.T) {
+	err := &SchemaMismatchError{
+		Sheet:          "test",
+		MissingFields:  []MissingField{{Field: "Type.Field", Name: "Field"}},
+		UnknownColumns: []UnknownColumn{{Name: "Foo", Column: "B", Index: 1}, {Name: "Bar", Column: "D", Index: 3}},
+	}
+
+	const msg = `gsheets: field not found in sheet: "Field"
+gsheets: field not found in struct: "Foo" in column "B"
+gsheets: field not found in struct: "Bar" in column "D"`
+
+	assert.Equal(t, msg, err.Error())
+}
+
+func TestSchemaMismatchError_Unwrap(t *testing.T) {
+	err := &SchemaMismatchError{
+		MissingFields:  []MissingField{{Field: "Type.Field", Name: "Field"}},
+		UnknownColumns: []UnknownColumn{{Name: "Foo", Column: "B", Index: 1}},
+	}
+
+	errs := err.Unwrap()
+	require.Len(t, errs, 2)
+	assert.ErrorIs(t, errs[0], ErrFieldNotFoundInSheet)
+	assert.ErrorIs(t, errs[1], ErrFieldNotFoundInStruct)
+}
+
+func TestDuplicateColumnError_Error(t *testing.T) {
+	err := &DuplicateColumnError{Sheet: "test", Name: "Phone", Columns: []string{"B", "D", "AB"}, Indexes: []int{1, 3, 27}}
+	assert.Equal(t, `gsheets: duplicate column: "Phone" in columns ["B", "D", "AB"] of sheet "test"`, err.Error())
+	assert.ErrorIs(t, err, ErrDuplicateColumn)
+}
+
+func TestMappingError_ErrorWithHeader(t *testing.T) {
+	err := &MappingError{
+		Sheet:  "test",
+		Cell:   "A1",
+		Field:  "Type.Field",
+		Header: "E-Mail",
+		err:    errors.New("inner error"),
+	}
+
+	const msg = `inner error
+	sheet: "test"
+	cell: "A1"
+	field: "Type.Field"
+	header: "E-Mail"`
+
+	assert.Equal(t, msg, err.Error())
+}
+
+func TestAmbiguousColumnError_Error(t *testing.T) {
+	err := &AmbiguousColumnError{Sheet: "test", Field: "Type.Email", Headers: []string{"Email", "E-Mail"}, Columns: []string{"A", "C"}, Indexes: []int{0, 2}}
+	assert.Equal(t, `gsheets: ambiguous column: field "Type.Email" matches columns ["Email" (A), "E-Mail" (C)] of sheet "test"`, err.Error())
+	assert.ErrorIs(t, err, ErrAmbiguousColumn)
+}
+
+func TestSheetError_Error(t *testing.T) {
+	err := &SheetError{Sheet: "test", err: errors.New("inner error")}
+	assert.Equal(t, `gsheets: failed to parse sheet "test": inner error`, err.Error())
+}
+
+func TestSheetError_Unwrap(t *testing.T) {
+	expectedErr := errors.New("inner error")
+	err := &SheetError{err: expectedErr}
+	assert.Equal(t, expectedErr, err.Unwrap())
+}
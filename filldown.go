@@ -0,0 +1,117 @@
+package gsheets
+
+import "google.golang.org/api/sheets/v4"
+
+// fillDown carries the last non-empty value of a column down to the empty cells below it,
+// and the value of the top-left cell of a merge to its other cells.
+type fillDown struct {
+	cfg    Config
+	values [][]any
+	cols   map[int]bool
+	last   map[int]string
+}
+
+// newFillDown returns the fillDown for the given mappings and values of the sheet,
+// or nil if no cells are to be filled down.
+func newFillDown(mappings []*mapping, cfg Config, values [][]any) *fillDown {
+	f := &fillDown{cfg: cfg, values: values, cols: make(map[int]bool), last: make(map[int]string)}
+	for _, m := range mappings {
+		if m.fillDown {
+			for _, colIdx := range m.colIndexes {
+				f.cols[colIdx] = true
+			}
+		}
+	}
+
+	if !cfg.fillDown && len(cfg.merges) == 0 && len(f.cols) == 0 {
+		return nil
+	}
+	return f
+}
+
+// next returns the values filled down to the empty cells of the given row, and records its non-empty cells.
+func (f *fillDown) next(row []any, rowIdx int) map[int]string {
+	if f == nil {
+		return nil
+	}
+
+	filled := make(map[int]string)
+	for colIdx, cell := range row {
+		if cv := cell.(string); cv != "" {
+			f.last[colIdx] = cv
+			continue
+		}
+		if f.cfg.fillDown || f.cols[colIdx] {
+			if last, ok := f.last[colIdx]; ok {
+				filled[colIdx] = last
+			}
+			continue
+		}
+		if cv, ok := f.merged(colIdx, rowIdx); ok {
+			filled[colIdx] = cv
+		}
+	}
+	return filled
+}
+
+// merged returns the value of the top-left cell of the merge, which contains the cell with the given index
+// in the record with the given index, and whether there is such a non-empty value.
+func (f *fillDown) merged(colIdx, rowIdx int) (string, bool) {
+	row, col := f.cfg.cellIndex(colIdx, rowIdx)
+	for _, merge := range f.cfg.merges {
+		if row >= int(merge.StartRowIndex) && row < int(merge.EndRowIndex) &&
+			col >= int(merge.StartColumnIndex) && col < int(merge.EndColumnIndex) {
+			return f.cell(int(merge.StartRowIndex), int(merge.StartColumnIndex))
+		}
+	}
+	return "", false
+}
+
+// cell returns the value of the cell with the given 0-based row and column index within the sheet,
+// and whether it's a non-empty cell of the fetched values. It's the inverse of Config.cellIndex.
+func (f *fillDown) cell(row, col int) (string, bool) {
+	recordIdx, idx := row, col
+	if f.cfg.majorDimension == MajorDimensionColumns {
+		recordIdx, idx = col, row
+	}
+	recordIdx -= f.cfg.recordOffset
+	idx -= f.cfg.cellOffset
+	if recordIdx < 0 || recordIdx >= len(f.values) || idx < 0 || idx >= len(f.values[recordIdx]) {
+		return "", false
+	}
+	cv, _ := f.values[recordIdx][idx].(string)
+	return cv, cv != ""
+}
+
+// loadMerges fetches the merged cells of the sheet, if they are required to fill down values.
+func (c *Config) loadMerges() error {
+	if !c.fillDownMerges {
+		return nil
+	}
+
+	merges, err := c.fetchMerges(*c)
+	if err != nil {
+		return err
+	}
+	c.merges = merges
+	return nil
+}
+
+type fetchMergesFN func(cfg Config) ([]*sheets.GridRange, error)
+
+func fetchMergesViaGoogleAPI(cfg Config) ([]*sheets.GridRange, error) {
+	resp, err := cfg.Service.Spreadsheets.Get(cfg.spreadsheetID).
+		Ranges(cfg.sheetName).
+		Fields("sheets(merges)").
+		Context(cfg.Context()).
+		Do()
+	if err != nil {
+		return nil, err
+	}
+
+	var merges []*sheets.GridRange
+	for _, sheet := range resp.Sheets {
+		merges = append(merges, sheet.Merges...)
+	}
+	return merges, nil
+}
@@ -0,0 +1,176 @@
+package gsheets
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+)
+
+// ParseSheetIntoGroups parses a sheet, which lists parent rows followed by their child rows, e.g. orders and their
+// items, into a slice of parents with the given type.
+// The parent type needs a field tagged with `groupkey`, e.g. `gsheets:"Order ID,groupkey"`, whose column is non-empty
+// in parent rows and empty in child rows, and a field of type []C or []*C tagged with `children`, which receives the
+// child rows following its parent row. Both types are mapped to the same header, so a column must only be mapped by
+// one of them. If an error budget is defined, erroneous groups are skipped, see ParseSheetIntoStructSlice.
+// The budget then refers to groups instead of rows, e.g. WithMaxErrorRatio(0.1) tolerates up to 10% erroneous groups.
+func ParseSheetIntoGroups[T any](cfg Config, opts ...ConfigOption) ([]T, error) {
+	cfg, err := cfg.init(reflect.TypeFor[T](), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cfg.fetch(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.loadMerges(); err != nil {
+		return nil, err
+	}
+
+	captions, data := splitHeader(cfg, resp.Values)
+	g, err := newGrouping(reflect.TypeFor[T](), captions, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, n := tableRows(cfg, resp.Values, data, g.parent, g.child)
+	groups, err := collectResults(cfg, g.groups(rows, cfg), n)
+	if err != nil && !errors.Is(err, ErrRowsSkipped) {
+		return nil, err
+	}
+
+	items := make([]T, 0, len(groups))
+	for _, group := range groups {
+		items = append(items, group.Interface().(T))
+	}
+	return items, err
+}
+
+// grouping holds the mappings of a parent type and its child type.
+type grouping struct {
+	t         reflect.Type
+	childType reflect.Type
+	isPointer bool
+	parent    []*mapping
+	child     []*mapping
+	key       *mapping
+	children  *mapping
+}
+
+func newGrouping(t reflect.Type, captions [][]any, cfg Config) (*grouping, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, t.Kind().String())
+	}
+
+	// unknown columns are checked for both types together
+	mappingCfg := cfg
+	mappingCfg.allowSkipColumns = true
+	mappingCfg.multipleTypes = true
+
+	// only the parent type receives child rows
+	parentCfg := mappingCfg
+	parentCfg.allowChildren = true
+
+	g := &grouping{t: t}
+	var err error
+	if g.parent, err = createMappings(t, captions, parentCfg); err != nil {
+		return nil, err
+	}
+
+	for _, m := range g.parent {
+		switch {
+		case m.groupKey && m.colIndex >= 0:
+			g.key = m
+		case m.children:
+			g.children = m
+		}
+	}
+	if g.key == nil || g.children == nil {
+		return nil, fmt.Errorf("%w: type %q needs a mapped field tagged with %q, and a field tagged with %q",
+			ErrInvalidTag, t, tagOptionGroupKey, tagOptionChildren)
+	}
+
+	if g.children.field.Type.Kind() == reflect.Slice {
+		g.childType, g.isPointer = indirect(g.children.field.Type.Elem())
+	}
+	if g.childType == nil || g.childType.Kind() != reflect.Struct || g.childType == timeType {
+		return nil, fmt.Errorf("%w: field %q of type %q must be a slice of structs",
+			ErrUnsupportedType, g.children.field.Name, g.children.field.Type)
+	}
+
+	if g.child, err = createMappings(g.childType, captions, mappingCfg); err != nil {
+		return nil, err
+	}
+
+	if err := checkUnknownColumns(captions, cfg, mappedColumns(g.parent, g.child)); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+// groups returns an iterator over the parents converted from the given rows, along with their children.
+// The yielded index is the record index of the parent row. The first error of a parent row or any of its child rows
+// is yielded for the whole group.
+func (g *grouping) groups(rows iter.Seq2[int, tableRow], cfg Config) iter.Seq2[int, Result[reflect.Value]] {
+	return func(yield func(int, Result[reflect.Value]) bool) {
+		var parent reflect.Value
+		var parentIdx int
+		var parentErr error
+		flush := func() bool {
+			if !parent.IsValid() {
+				return true
+			}
+			if parentErr != nil {
+				return yield(parentIdx, Result[reflect.Value]{Err: parentErr})
+			}
+			return yield(parentIdx, Result[reflect.Value]{Val: parent})
+		}
+
+		for rowIdx, row := range rows {
+			if row.cells[g.key.colIndex].(string) != "" {
+				if !flush() {
+					return
+				}
+				parent, parentIdx = reflect.New(g.t).Elem(), rowIdx
+				parentErr = convertRow(parent, row, rowIdx, g.parent, cfg)
+				continue
+			}
+
+			if !parent.IsValid() {
+				err := &MappingError{
+					Sheet:  cfg.sheetName,
+					Cell:   cfg.cellName(g.key.colIndex, rowIdx),
+					Field:  g.key.typeName + "." + g.key.field.Name,
+					Header: g.key.header,
+					err:    ErrNoParentRow,
+				}
+				if !yield(rowIdx, Result[reflect.Value]{Err: err}) {
+					return
+				}
+				continue
+			}
+
+			child := reflect.New(g.childType).Elem()
+			if err := convertRow(child, row, rowIdx, g.child, cfg); err != nil && parentErr == nil {
+				parentErr = err
+			}
+			if parentErr != nil {
+				continue
+			}
+
+			if g.isPointer {
+				child = child.Addr()
+			}
+			if g.children.initEmbedPtr != nil {
+				g.children.initEmbedPtr(parent)
+			}
+			children := parent.FieldByIndex(g.children.field.Index)
+			children.Set(reflect.Append(children, child))
+		}
+
+		flush()
+	}
+}
@@ -0,0 +1,207 @@
+package gsheets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestParseSheetIntoGroups(t *testing.T) {
+	type itemT struct {
+		SKU      string
+		Quantity int
+	}
+	type orderT struct {
+		ID       string `gsheets:"Order ID,groupkey"`
+		Customer string
+		Items    []itemT `gsheets:",children"`
+	}
+
+	cfg := MakeConfig(_svc, "test-workbook", WithSheetName("orders"))
+	fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+		return &sheets.ValueRange{
+			Values: [][]any{
+				{"Order ID", "Customer", "SKU", "Quantity"},
+				{"1", "foo"},
+				{"", "", "A-1", "2"},
+				{"", "", "B-2", "1"},
+				{"2", "bar"},
+				{},
+				{"3", "baz"},
+				{"", "", "A-1", "5"},
+			},
+		}, nil
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Run("error from fetch method", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoGroups[orderT](cfg, withFetch(errorFetcher))
+			assert.ErrorIs(t, err, fetcherError)
+		})
+
+		t.Run("missing tags", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				ID       string `gsheets:"Order ID"`
+				Customer string
+				Items    []itemT `gsheets:",children"`
+			}
+
+			_, err := ParseSheetIntoGroups[invalidT](cfg, fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+		})
+
+		t.Run("children outside of groups", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructs[orderT](cfg, fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+
+			type nestedT struct {
+				SKU      string
+				Quantity int
+				Parts    []itemT `gsheets:",children"`
+			}
+			type parentT struct {
+				ID       string `gsheets:"Order ID,groupkey"`
+				Customer string
+				Items    []nestedT `gsheets:",children"`
+			}
+
+			_, err = ParseSheetIntoGroups[parentT](cfg, fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+		})
+
+		t.Run("budget of groups", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoGroups[orderT](cfg,
+				WithMaxErrorRatio(0.5),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"Order ID", "Customer", "SKU", "Quantity"},
+							{"1", "foo"},
+							{"", "", "A-1", "many"},
+							{"", "", "B-2", "1"},
+							{"", "", "C-3", "1"},
+							{"2", "bar"},
+							{"", "", "A-1", "few"},
+							{"", "", "B-2", "1"},
+							{"", "", "C-3", "1"},
+							{"3", "baz"},
+						},
+					}, nil
+				}),
+			)
+			var budgetErr *ErrorBudgetError
+			require.ErrorAs(t, err, &budgetErr)
+			assert.Equal(t, 3, budgetErr.Rows)
+			assert.Equal(t, 1, budgetErr.Allowed)
+		})
+
+		t.Run("unknown column", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				ID    string  `gsheets:"Order ID,groupkey"`
+				Items []itemT `gsheets:",children"`
+			}
+
+			_, err := ParseSheetIntoGroups[invalidT](cfg, fetcher)
+			var mismatchErr *SchemaMismatchError
+			require.ErrorAs(t, err, &mismatchErr)
+			assert.Equal(t, []UnknownColumn{{Name: "Customer", Column: "B", Index: 1}}, mismatchErr.UnknownColumns)
+		})
+
+		t.Run("child without parent", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoGroups[orderT](cfg,
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"Order ID", "Customer", "SKU", "Quantity"},
+							{"", "", "A-1", "2"},
+						},
+					}, nil
+				}),
+			)
+			var mappingErr *MappingError
+			require.ErrorAs(t, err, &mappingErr)
+			assert.ErrorIs(t, err, ErrNoParentRow)
+			assert.Equal(t, "A2", mappingErr.Cell)
+		})
+
+		t.Run("child error", func(t *testing.T) {
+			t.Parallel()
+
+			orders, err := ParseSheetIntoGroups[orderT](cfg,
+				WithMaxErrors(1),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"Order ID", "Customer", "SKU", "Quantity"},
+							{"1", "foo"},
+							{"", "", "A-1", "many"},
+							{"2", "bar"},
+							{"", "", "A-1", "1"},
+						},
+					}, nil
+				}),
+			)
+			assert.ErrorIs(t, err, ErrRowsSkipped)
+			assert.Equal(t, []orderT{{ID: "2", Customer: "bar", Items: []itemT{{SKU: "A-1", Quantity: 1}}}}, orders)
+		})
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		orders, err := ParseSheetIntoGroups[orderT](cfg, fetcher)
+		require.NoError(t, err)
+		assert.Equal(t, []orderT{
+			{ID: "1", Customer: "foo", Items: []itemT{{SKU: "A-1", Quantity: 2}, {SKU: "B-2", Quantity: 1}}},
+			{ID: "2", Customer: "bar"},
+			{ID: "3", Customer: "baz", Items: []itemT{{SKU: "A-1", Quantity: 5}}},
+		}, orders)
+	})
+
+	t.Run("pointers", func(t *testing.T) {
+		t.Parallel()
+
+		type ptrOrderT struct {
+			ID       string `gsheets:"Order ID,groupkey"`
+			Customer string
+			Items    []*itemT `gsheets:",children"`
+		}
+
+		orders, err := ParseSheetIntoGroups[ptrOrderT](cfg, fetcher)
+		require.NoError(t, err)
+		require.Len(t, orders, 3)
+		assert.Equal(t, []*itemT{{SKU: "A-1", Quantity: 5}}, orders[2].Items)
+	})
+
+	t.Run("duplicate columns", func(t *testing.T) {
+		t.Parallel()
+
+		orders, err := ParseSheetIntoGroups[orderT](cfg, WithDuplicateColumns(DuplicateColumnsFirst),
+			withFetch(func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"Order ID", "Customer", "SKU", "Quantity", "Quantity"},
+						{"1", "foo"},
+						{"", "", "A-1", "2", "3"},
+					},
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []orderT{{ID: "1", Customer: "foo", Items: []itemT{{SKU: "A-1", Quantity: 2}}}}, orders)
+	})
+}
@@ -0,0 +1,101 @@
+package gsheets
+
+import (
+	"slices"
+	"strings"
+)
+
+// groupSeparator separates the captions of multiple header rows, when they are combined for diagnostics.
+const groupSeparator = " / "
+
+// sheetHeader holds the captions of the header row(s) of a sheet, within the bounds of the table.
+type sheetHeader struct {
+	// captions holds the display caption of each column
+	captions []string
+	// paths holds the non-empty captions of each column, from the top to the bottom header row
+	paths [][]string
+	// colNames maps the keys of the captions to the indexes of the columns
+	colNames map[string][]int
+}
+
+func newSheetHeader(rows [][]any, opts Config) *sheetHeader {
+	paths := headerPaths(rows)
+	h := &sheetHeader{
+		captions: make([]string, 0, len(paths)),
+		colNames: make(map[string][]int, len(paths)),
+	}
+	for _, path := range paths {
+		h.captions = append(h.captions, strings.Join(path, groupSeparator))
+	}
+	h.captions = h.captions[:max(0, min(opts.tableEnd(h.captions), len(h.captions)))]
+	h.paths = paths[:len(h.captions)]
+
+	for colIdx, caption := range h.captions {
+		if caption == "" {
+			if opts.skipBlankHeaders {
+				continue
+			}
+			h.captions, h.paths = h.captions[:colIdx], h.paths[:colIdx]
+			break
+		}
+		key := opts.headerKey(h.paths[colIdx]...)
+		h.colNames[key] = append(h.colNames[key], colIdx)
+	}
+
+	return h
+}
+
+// remove removes the column with the given index from the columns to be mapped.
+func (h *sheetHeader) remove(colIdx int) {
+	for key, idxs := range h.colNames {
+		if !slices.Contains(idxs, colIdx) {
+			continue
+		}
+		if idxs = slices.DeleteFunc(slices.Clone(idxs), func(idx int) bool { return idx == colIdx }); len(idxs) > 0 {
+			h.colNames[key] = idxs
+		} else {
+			delete(h.colNames, key)
+		}
+		return
+	}
+}
+
+// headerPaths combines the given header rows into the caption path of each column.
+// The API returns the caption of merged cells only for their first column. Therefore, group captions are carried
+// to the right, as long as the columns below them have captions, and their parent group is carried as well.
+func headerPaths(rows [][]any) [][]string {
+	var width int
+	for _, row := range rows {
+		width = max(width, len(row))
+	}
+
+	paths := make([][]string, 0, width)
+	var prev []string
+	for colIdx := range width {
+		cur := make([]string, len(rows))
+		carried := make([]bool, len(rows))
+		for r, row := range rows {
+			if colIdx < len(row) {
+				cur[r] = row[colIdx].(string)
+			}
+			if cur[r] != "" || r == len(rows)-1 || prev == nil || prev[r] == "" || prev[r+1] == "" {
+				continue
+			}
+			if r == 0 || carried[r-1] {
+				cur[r] = prev[r]
+				carried[r] = true
+			}
+		}
+
+		path := make([]string, 0, len(cur))
+		for _, caption := range cur {
+			if caption != "" {
+				path = append(path, caption)
+			}
+		}
+		paths = append(paths, path)
+		prev = cur
+	}
+
+	return paths
+}
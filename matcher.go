@@ -0,0 +1,32 @@
+package gsheets
+
+import (
+	"strings"
+	"unicode"
+)
+
+// HeaderMatcher normalizes header captions and column names of struct fields into comparable keys.
+// A column is mapped to a field, if both are normalized to the same key.
+type HeaderMatcher func(name string) string
+
+// MatchExact matches header captions exactly as they are. This is the default.
+func MatchExact(name string) string {
+	return name
+}
+
+// MatchCaseInsensitive matches header captions regardless of their case,
+// e.g. "Created At" matches "created at".
+func MatchCaseInsensitive(name string) string {
+	return strings.ToLower(name)
+}
+
+// MatchNormalized matches header captions regardless of their case, whitespace and punctuation,
+// e.g. "Created At", "created_at", "Created  At " and the field name "CreatedAt" all match each other.
+func MatchNormalized(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, name)
+}
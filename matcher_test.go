@@ -0,0 +1,33 @@
+package gsheets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderMatchers(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher HeaderMatcher
+		a, b    string
+		want    bool
+	}{
+		{name: "exact", matcher: MatchExact, a: "Created At", b: "Created At", want: true},
+		{name: "exact case", matcher: MatchExact, a: "Created At", b: "created at", want: false},
+		{name: "case-insensitive", matcher: MatchCaseInsensitive, a: "Created At", b: "created at", want: true},
+		{name: "case-insensitive whitespace", matcher: MatchCaseInsensitive, a: "Created At", b: "Created  At ", want: false},
+		{name: "normalized whitespace", matcher: MatchNormalized, a: "Created At", b: "Created  At ", want: true},
+		{name: "normalized snake", matcher: MatchNormalized, a: "Created At", b: "created_at", want: true},
+		{name: "normalized camel", matcher: MatchNormalized, a: "CreatedAt", b: "created-at", want: true},
+		{name: "normalized different", matcher: MatchNormalized, a: "Created At", b: "Updated At", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.matcher(tt.a) == tt.matcher(tt.b))
+		})
+	}
+}
@@ -1,9 +1,11 @@
 package gsheets
 
 import (
+	"errors"
 	"fmt"
 	"iter"
 	"reflect"
+	"slices"
 	"strings"
 
 	"google.golang.org/api/sheets/v4"
@@ -21,107 +23,426 @@ type Result[T any] struct {
 // Parsing errors are returned as part of the Result, and can therefore be handled by the caller.
 // The iterator will still proceed to the next row, if it isn't stopped.
 func ParseSheetIntoStructs[T any](cfg Config, opts ...ConfigOption) (iter.Seq2[int, Result[T]], error) {
-	results, _, err := parseSheet[T](cfg, opts)
+	results, _, _, err := parseSheet[T](cfg, opts)
 	return results, err
 }
 
 // ParseSheetIntoStructSlice parses a sheet page and returns a slice of structs with the give type.
 // If an error occurs, the function will immediately return it.
+// If an error budget is defined via WithMaxErrors or WithMaxErrorRatio, erroneous rows are skipped instead,
+// until the budget is exceeded, and an *ErrorBudgetError is returned. If rows were skipped within the budget,
+// the slice is returned along with a *SkippedRowsError holding their errors, which matches ErrRowsSkipped.
 func ParseSheetIntoStructSlice[T any](cfg Config, opts ...ConfigOption) ([]T, error) {
-	results, rows, err := parseSheet[T](cfg, opts)
+	results, cfg, rows, err := parseSheet[T](cfg, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	items := make([]T, 0, rows)
-	for _, item := range results {
-		if item.Err != nil {
-			return nil, item.Err
+	return collectResults(cfg, results, rows)
+}
+
+// ParseSheetIntoStruct parses a key/value sheet page, e.g. with settings, into a single struct of the given type.
+// The keys in column A are mapped to the struct fields, and the values in column B are converted accordingly.
+// By default, the first row is expected to hold captions, e.g. "Key | Value", and is skipped.
+// Use WithHeaderless(true) for sheets without such a row.
+// With WithMajorDimension(MajorDimensionColumns), the keys are read from row 1 and the values from row 2 instead.
+// Like the captions of a header, the keys end at the first blank key cell, unless WithSkipBlankHeaders(true) is set.
+func ParseSheetIntoStruct[T any](cfg Config, opts ...ConfigOption) (T, error) {
+	var item T
+	cfg, err := cfg.init(reflect.TypeFor[T](), opts)
+	if err != nil {
+		return item, err
+	}
+
+	// fetching in the other dimension turns the keys into the captions, and the values into a single record
+	if cfg.majorDimension == MajorDimensionColumns {
+		cfg.majorDimension = MajorDimensionRows
+	} else {
+		cfg.majorDimension = MajorDimensionColumns
+	}
+	resp, err := cfg.fetch(cfg)
+	if err != nil {
+		return item, err
+	}
+
+	if err := cfg.loadMerges(); err != nil {
+		return item, err
+	}
+
+	values := make([][]any, 2)
+	copy(values, resp.Values)
+	if !cfg.headerless {
+		for i, line := range values {
+			if len(line) > 0 {
+				values[i] = line[1:]
+			}
 		}
-		items = append(items, item.Val)
+		cfg.cellOffset++
 	}
+	// the row options apply to the rows of a table, but not to the single record of the values
+	cfg.headerless, cfg.headerRows, cfg.keepBlankRows = false, 1, true
+	cfg.commentPrefix, cfg.rowFilter, cfg.stopAtBlankRow, cfg.stopAt, cfg.maxRows = "", nil, false, nil, 0
 
-	return items, cfg.Context().Err()
+	results, _, err := parseValues[T](cfg, values)
+	if err != nil {
+		return item, err
+	}
+
+	for _, result := range results {
+		return result.Val, result.Err
+	}
+
+	if err := cfg.Context().Err(); err != nil {
+		return item, err
+	}
+	return item, fmt.Errorf("%w: sheet %q", ErrNoRecord, cfg.sheetName)
 }
 
-func parseSheet[T any](cfg Config, opts []ConfigOption) (iter.Seq2[int, Result[T]], int, error) {
-	refT := reflect.TypeFor[T]()
-	cfg, err := cfg.init(refT, opts)
+// parseSheet fetches the sheet and returns an iterator over the converted rows,
+// along with the built Config and the number of data rows.
+func parseSheet[T any](cfg Config, opts []ConfigOption) (iter.Seq2[int, Result[T]], Config, int, error) {
+	cfg, err := cfg.init(reflect.TypeFor[T](), opts)
 	if err != nil {
-		return nil, 0, err
+		return nil, cfg, 0, err
 	}
 
 	resp, err := cfg.fetch(cfg)
+	if err != nil {
+		return nil, cfg, 0, err
+	}
+
+	if err := cfg.loadMerges(); err != nil {
+		return nil, cfg, 0, err
+	}
+
+	results, rows, err := parseValues[T](cfg, resp.Values)
+	return results, cfg, rows, err
+}
+
+// parseValues maps the given values of a sheet, and returns an iterator over the converted rows,
+// along with the number of data rows.
+func parseValues[T any](cfg Config, values [][]any) (iter.Seq2[int, Result[T]], int, error) {
+	results, rows, err := parseValuesOf(reflect.TypeFor[T](), cfg, values)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	mappings, err := createMappings(refT, resp.Values[0], cfg)
+	return func(yield func(int, Result[T]) bool) {
+		for rowIdx, item := range results {
+			if item.Err != nil {
+				if !yield(rowIdx, Result[T]{Err: item.Err}) {
+					return
+				}
+				continue
+			}
+
+			if !yield(rowIdx, Result[T]{Val: item.Val.Interface().(T)}) {
+				return
+			}
+		}
+	}, rows, nil
+}
+
+// parseValuesOf maps the given values of a sheet to the given struct type, and returns an iterator over the
+// converted rows as addressable values of that type, along with the number of data rows.
+func parseValuesOf(t reflect.Type, cfg Config, values [][]any) (iter.Seq2[int, Result[reflect.Value]], int, error) {
+	captions, data := splitHeader(cfg, values)
+	mappings, err := createMappings(t, captions, cfg)
 	if err != nil {
 		return nil, 0, err
 	}
 
-	fillEmptyValues(resp)
+	results, n := convertRows(t, cfg, values, data, mappings)
+	return results, n, cfg.Context().Err()
+}
+
+// convertRows converts the given data rows of a sheet into addressable values of the given struct type,
+// and returns an iterator over them, along with the number of data rows.
+func convertRows(t reflect.Type, cfg Config, values, data [][]any, mappings []*mapping) (iter.Seq2[int, Result[reflect.Value]], int) {
+	rows, n := tableRows(cfg, values, data, mappings)
+	value := unpivotValueMapping(mappings)
+	return func(yield func(int, Result[reflect.Value]) bool) {
+		for rowIdx, row := range rows {
+			records := []tableRow{row}
+			if value != nil {
+				records = row.unpivot(value)
+			}
+
+			for _, record := range records {
+				item := reflect.New(t).Elem()
+				if err := convertRow(item, record, rowIdx, mappings, cfg); err != nil {
+					if !yield(rowIdx, Result[reflect.Value]{Err: err}) {
+						return
+					}
+					continue
+				}
+
+				if !yield(rowIdx, Result[reflect.Value]{Val: item}) {
+					return
+				}
+			}
+		}
+	}, n
+}
+
+// splitHeader splits the given values of a sheet into the header rows and the data rows.
+func splitHeader(cfg Config, values [][]any) ([][]any, [][]any) {
+	if cfg.headerless {
+		return nil, values
+	}
+
+	n := min(cfg.headerRows, len(values))
+	return values[:n], values[n:]
+}
+
+// tableRow holds the cells of a single row of the table, along with the values filled down to its empty cells.
+// The cells are padded to the width of the table, while raw holds the cells as fetched.
+// If columns are unpivoted, pivot holds the index of the unpivoted column within the columns of the value field.
+type tableRow struct {
+	cells  []any
+	raw    []any
+	filled map[int]string
+	pivot  int
+}
+
+// cell returns the value of the cell with the given index, or the value filled down to it, if it's empty.
+func (r tableRow) cell(colIdx int) string {
+	cv := r.cells[colIdx].(string)
+	if v, ok := r.filled[colIdx]; ok && cv == "" {
+		return v
+	}
+	return cv
+}
+
+// unpivot turns each non-empty cell of the unpivoted columns into its own record.
+func (r tableRow) unpivot(value *mapping) []tableRow {
+	records := make([]tableRow, 0, len(value.colIndexes))
+	for i, colIdx := range value.colIndexes {
+		if r.cell(colIdx) != "" {
+			record := r
+			record.pivot = i
+			records = append(records, record)
+		}
+	}
+	return records
+}
+
+// unpivotValueMapping returns the mapping of the field receiving the cells of unpivoted columns, if any.
+func unpivotValueMapping(mappings []*mapping) *mapping {
+	for _, m := range mappings {
+		if m.unpivotValue {
+			return m
+		}
+	}
+	return nil
+}
+
+// tableRows returns an iterator over the rows of the table within the given data rows of the sheet, which are not
+// skipped, along with the number of rows of the table. The yielded index is the 1-based record index.
+func tableRows(cfg Config, values, data [][]any, mappings ...[]*mapping) (iter.Seq2[int, tableRow], int) {
+	all := slices.Concat(mappings...)
+	fetched := slices.Clone(data) // padding appends to the rows, leaving the cells of the cloned slices as fetched
+	fillEmptyValues(values, mappingsWidth(all))
 
 	ctx := cfg.Context()
-	return func(yield func(int, Result[T]) bool) {
-	rows:
-		for i, row := range resp.Values[1:] {
+	offset := len(values) - len(data) + 1 + cfg.recordOffset // 1-based index + captions + preceding records
+	data = data[:cfg.tableLength(data, offset)]
+	fetched = fetched[:len(data)]
+	return func(yield func(int, tableRow) bool) {
+		fill := newFillDown(all, cfg, values)
+		for i, cells := range data {
 			select {
 			case <-ctx.Done():
 				return
 			default:
-				rowIdx := i + 2 // 1-based index + first row is captions
-				var item T
-				refItem := reflect.ValueOf(&item).Elem()
-				for _, mapping := range mappings {
-					val, nonEmpty, err := mapping.convert(row[mapping.colIndex].(string), cfg.datetimeFormats)
-					if err != nil {
-						err = &MappingError{
-							Sheet: cfg.sheetName,
-							Cell:  fmt.Sprintf("%s%d", columnName(mapping.colIndex), rowIdx),
-							Field: mapping.typeName + "." + mapping.field.Name,
-							err:   err,
-						}
-						if !yield(rowIdx, Result[T]{Err: err}) {
-							return
-						}
-
-						continue rows
-					}
+				rowIdx := i + offset
+				if cfg.skipRow(rowIdx, cells) {
+					continue
+				}
 
-					if !nonEmpty {
-						continue
-					}
+				row := tableRow{cells: cells, raw: fetched[i], filled: fill.next(cells, rowIdx)}
+				if !yield(rowIdx, row) {
+					return
+				}
+			}
+		}
+	}, len(data)
+}
 
-					if mapping.initEmbedPtr != nil {
-						mapping.initEmbedPtr(refItem)
-					}
+// collectResults collects the converted rows of a sheet into a slice.
+// Without an error budget, the first erroneous row aborts the collection, otherwise it's skipped until the budget
+// is exceeded. The errors of the skipped rows are returned as *SkippedRowsError along with the slice.
+// The given number of data rows is only used to preallocate the slice.
+func collectResults[T any](cfg Config, results iter.Seq2[int, Result[T]], rows int) ([]T, error) {
+	var errs []error
+	converted := 0
+	items := make([]T, 0, rows)
+	for _, item := range results {
+		converted++
+		if item.Err != nil {
+			if !cfg.errorBudget {
+				return nil, item.Err
+			}
+			errs = append(errs, item.Err)
+			if err := cfg.checkErrorBudget(errs, converted, false); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		items = append(items, item.Val)
+	}
 
-					refItem.FieldByIndex(mapping.field.Index).Set(val)
-				}
+	if err := cfg.Context().Err(); err != nil {
+		return items, err
+	}
 
-				if !yield(rowIdx, Result[T]{Val: item}) {
-					return
+	if err := cfg.checkErrorBudget(errs, converted, true); err != nil {
+		if errors.Is(err, ErrErrorBudgetExceeded) {
+			return nil, err
+		}
+		return items, err
+	}
+	return items, nil
+}
+
+// convertRow converts the cells of a single row into the given struct value.
+// By default, the first failing cell aborts the conversion and its *MappingError is returned.
+// If the collection of row errors is enabled, all cells are converted and the failures are returned as *RowError.
+// Empty cells are converted with the values filled down to them, if any.
+func convertRow(refItem reflect.Value, row tableRow, rowIdx int, mappings []*mapping, cfg Config) error {
+	var errs []error
+	convert := func(mapping *mapping, colIdx int, field string) (reflect.Value, bool, bool) {
+		var cv string
+		if colIdx >= 0 {
+			cv = row.cell(colIdx)
+		}
+		val, nonEmpty, err := mapping.convert(cv, cfg.datetimeFormats)
+		if err != nil {
+			var cell string
+			if colIdx >= 0 {
+				cell = cfg.cellName(colIdx, rowIdx)
+			}
+			errs = append(errs, &MappingError{
+				Sheet:  cfg.sheetName,
+				Cell:   cell,
+				Field:  mapping.typeName + "." + field,
+				Header: mapping.header,
+				Value:  cv,
+				err:    err,
+			})
+			return val, false, !cfg.collectRowErrors
+		}
+		return val, nonEmpty, false
+	}
+
+	for _, mapping := range mappings {
+		if mapping.children {
+			continue
+		}
+		if mapping.meta != "" {
+			mapping.setMetadata(refItem, row.raw, rowIdx, cfg)
+			continue
+		}
+		if mapping.unpivot {
+			if mapping.initEmbedPtr != nil {
+				mapping.initEmbedPtr(refItem)
+			}
+			refItem.FieldByIndex(mapping.field.Index).Set(mapping.pivotValues[row.pivot])
+			continue
+		}
+
+		var val reflect.Value
+		var nonEmpty, abort bool
+		if mapping.isSlice {
+			val = reflect.MakeSlice(mapping.field.Type, len(mapping.colIndexes), len(mapping.colIndexes))
+			for i, colIdx := range mapping.colIndexes {
+				elem, ok, stop := convert(mapping, colIdx, fmt.Sprintf("%s[%d]", mapping.field.Name, i))
+				if abort = stop; abort {
+					break
+				}
+				if ok {
+					val.Index(i).Set(elem)
+					nonEmpty = true
 				}
 			}
+		} else if mapping.unpivotValue {
+			val, nonEmpty, abort = convert(mapping, mapping.colIndexes[row.pivot], mapping.field.Name)
+		} else {
+			val, nonEmpty, abort = convert(mapping, mapping.colIndex, mapping.field.Name)
 		}
-	}, len(resp.Values[1:]), ctx.Err()
+
+		if abort {
+			return errs[0]
+		}
+
+		if !nonEmpty {
+			continue
+		}
+
+		if mapping.initEmbedPtr != nil {
+			mapping.initEmbedPtr(refItem)
+		}
+
+		refItem.FieldByIndex(mapping.field.Index).Set(val)
+	}
+
+	if len(errs) > 0 {
+		return &RowError{Sheet: cfg.sheetName, Row: rowIdx, errs: errs}
+	}
+
+	return nil
 }
 
-func fillEmptyValues(data *sheets.ValueRange) {
-	var maxWidth int
-	for _, row := range data.Values {
+func fillEmptyValues(values [][]any, minWidth int) {
+	maxWidth := minWidth
+	for _, row := range values {
 		if len(row) > maxWidth {
 			maxWidth = len(row)
 		}
 	}
 
-	for rowIdx, row := range data.Values {
+	for rowIdx, row := range values {
 		for colIdx := len(row); colIdx < maxWidth; colIdx++ {
-			data.Values[rowIdx] = append(data.Values[rowIdx], "")
+			values[rowIdx] = append(values[rowIdx], "")
+		}
+	}
+}
+
+// rowCells returns the cells of the given row as strings.
+func rowCells(row []any) []string {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		cells[i] = cell.(string)
+	}
+	return cells
+}
+
+// mappingsWidth returns the number of columns required by the given mappings.
+func mappingsWidth(mappings []*mapping) int {
+	var width int
+	for _, m := range mappings {
+		for _, idx := range m.colIndexes {
+			width = max(width, idx+1)
+		}
+	}
+	return width
+}
+
+// columnIndex returns the 0-based index of the given column letters, e.g. 2 for "C".
+// It returns -1 if the letters are invalid.
+func columnIndex(name string) int {
+	if name == "" {
+		return -1
+	}
+
+	var index int
+	for _, r := range strings.ToUpper(name) {
+		if r < 'A' || r > 'Z' {
+			return -1
 		}
+		index = index*26 + int(r-'A') + 1
 	}
+	return index - 1
 }
 
 func columnName(index int) string {
@@ -140,6 +461,6 @@ type fetchFN func(cfg Config) (*sheets.ValueRange, error)
 func fetchViaGoogleAPI(cfg Config) (*sheets.ValueRange, error) {
 	return cfg.Service.Spreadsheets.Values.Get(cfg.spreadsheetID, cfg.sheetName).
 		Context(cfg.Context()).
-		MajorDimension("ROWS").
+		MajorDimension(string(cfg.majorDimension)).
 		Do()
 }
@@ -30,6 +30,18 @@ func TestParseSheetIntoStructs(t *testing.T) {
 			assert.ErrorIs(t, err, ErrNoSpreadSheetID)
 		})
 
+		t.Run("invalid major dimension", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructs[allT](
+				Config{Service: _svc},
+				WithSpreadsheetID("foobar"),
+				WithMajorDimension("DIAGONAL"),
+				withFetch(errorFetcher),
+			)
+			assert.ErrorIs(t, err, ErrInvalidMajorDimension)
+		})
+
 		t.Run("error from fetch method", func(t *testing.T) {
 			t.Parallel()
 
@@ -105,6 +117,44 @@ func TestParseSheetIntoStructs(t *testing.T) {
 			assert.ErrorIs(t, err, ErrFieldNotFoundInSheet)
 		})
 
+		t.Run("schema mismatch", func(t *testing.T) {
+			t.Parallel()
+
+			type mismatchT struct {
+				A, X, C, Y string
+			}
+
+			_, err := ParseSheetIntoStructs[mismatchT](
+				Config{Service: _svc},
+				WithSpreadsheetID("invalid"),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"A", "B", "C", "D", "E", "F", "G", "H"},
+						},
+					}, nil
+				}),
+			)
+
+			var mismatchErr *SchemaMismatchError
+			require.ErrorAs(t, err, &mismatchErr)
+			assert.Equal(t, "mismatchTS", mismatchErr.Sheet)
+			assert.Equal(t, []MissingField{
+				{Field: getTypeName[mismatchT]() + ".X", Name: "X"},
+				{Field: getTypeName[mismatchT]() + ".Y", Name: "Y"},
+			}, mismatchErr.MissingFields)
+			assert.Equal(t, []UnknownColumn{
+				{Name: "B", Column: "B", Index: 1},
+				{Name: "D", Column: "D", Index: 3},
+				{Name: "E", Column: "E", Index: 4},
+				{Name: "F", Column: "F", Index: 5},
+				{Name: "G", Column: "G", Index: 6},
+				{Name: "H", Column: "H", Index: 7},
+			}, mismatchErr.UnknownColumns)
+			assert.ErrorIs(t, err, ErrFieldNotFoundInSheet)
+			assert.ErrorIs(t, err, ErrFieldNotFoundInStruct)
+		})
+
 		t.Run("no mappings", func(t *testing.T) {
 			t.Parallel()
 
@@ -269,6 +319,53 @@ func TestParseSheetIntoStructs(t *testing.T) {
 				}
 			})
 		})
+
+		t.Run("collect row errors", func(t *testing.T) {
+			t.Parallel()
+
+			results, err := ParseSheetIntoStructs[intsT](
+				MakeConfig(_svc, "invalid", WithSheetName("invalid")),
+				WithTagName("sheets"),
+				WithCollectRowErrors(true),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"intsT_value", "intsT_ptr"},
+							{"foo", "bar"},
+							{"1", "baz"},
+							{"2", "3"},
+						},
+					}, nil
+				}),
+			)
+			require.NoError(t, err)
+
+			next, stop := iter.Pull2(results)
+			defer stop()
+
+			r, item, _ := next()
+			var rowErr *RowError
+			require.ErrorAs(t, item.Err, &rowErr)
+			assert.Equal(t, 2, r)
+			assert.Equal(t, 2, rowErr.Row)
+			assert.Equal(t, "invalid", rowErr.Sheet)
+			require.Len(t, rowErr.Unwrap(), 2)
+			for i, cell := range []string{"A2", "B2"} {
+				var mappingErr *MappingError
+				require.ErrorAs(t, rowErr.Unwrap()[i], &mappingErr)
+				assert.Equal(t, cell, mappingErr.Cell)
+			}
+
+			r, item, _ = next()
+			require.ErrorAs(t, item.Err, &rowErr)
+			assert.Equal(t, 3, r)
+			require.Len(t, rowErr.Unwrap(), 1)
+
+			r, item, _ = next()
+			require.NoError(t, item.Err)
+			assert.Equal(t, 4, r)
+			assert.Equal(t, intsT{Value: 2, Ptr: ptrTo(3)}, item.Val)
+		})
 	})
 
 	cfg := MakeConfig(_svc, "test-workbook", WithSheetName("test-sheet"), WithDatetimeFormats("2.1.2006"))
@@ -318,6 +415,7 @@ func TestParseSheetIntoStructs(t *testing.T) {
 					WithTagName("sheets"),
 					WithAllowSkipColumns(true),
 					WithAllowSkipFields(true),
+					WithSkipBlankRows(false),
 					withFetch(func(cfg Config) (*sheets.ValueRange, error) {
 						assert.Equal(t, "test-workbook", cfg.spreadsheetID)
 						assert.Equal(t, "test-sheet", cfg.sheetName)
@@ -338,210 +436,1258 @@ func TestParseSheetIntoStructs(t *testing.T) {
 		}
 	})
 
-	t.Run("stop iter loop", func(t *testing.T) {
-		t.Parallel()
+	t.Run("duplicate columns", func(t *testing.T) {
+		type contactT struct {
+			Name  string
+			Phone string
+		}
+		type contactsT struct {
+			Name  string
+			Phone []*int
+		}
 
-		results, err := ParseSheetIntoStructs[stringsT](cfg,
-			WithTagName("sheets"),
-			withFetch(stringsFetcher),
+		cfg := MakeConfig(_svc, "test-workbook", WithSheetName("test-sheet"),
+			withFetch(func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"Name", "Phone", "Phone", "Phone"},
+						{"foo", "1", "", "3"},
+						{"bar"},
+					},
+				}, nil
+			}),
 		)
-		require.NoError(t, err)
 
-		records := make([]stringsT, 0, 2)
-		var i int
-		for _, item := range results {
-			records = append(records, item.Val)
-			if i++; i == 2 {
-				break
-			}
-		}
+		t.Run("error", func(t *testing.T) {
+			t.Parallel()
 
-		assert.Len(t, records, 2)
-	})
-}
+			_, err := ParseSheetIntoStructs[contactT](cfg)
+			var dupErr *DuplicateColumnError
+			require.ErrorAs(t, err, &dupErr)
+			assert.Equal(t, "test-sheet", dupErr.Sheet)
+			assert.Equal(t, "Phone", dupErr.Name)
+			assert.Equal(t, []int{1, 2, 3}, dupErr.Indexes)
+		})
 
-func TestParseSheetIntoStructSlice(t *testing.T) {
-	t.Run("errors", func(t *testing.T) {
-		t.Run("no service", func(t *testing.T) {
+		t.Run("first", func(t *testing.T) {
 			t.Parallel()
 
-			_, err := ParseSheetIntoStructSlice[allT](Config{}, withFetch(errorFetcher))
-			assert.ErrorIs(t, err, ErrNoService)
+			records, err := ParseSheetIntoStructSlice[contactT](cfg, WithDuplicateColumns(DuplicateColumnsFirst))
+			require.NoError(t, err)
+			assert.Equal(t, []contactT{{Name: "foo", Phone: "1"}, {Name: "bar"}}, records)
 		})
 
-		t.Run("no spreadsheet id", func(t *testing.T) {
+		t.Run("last", func(t *testing.T) {
 			t.Parallel()
 
-			_, err := ParseSheetIntoStructSlice[allT](Config{Service: _svc}, withFetch(errorFetcher))
-			assert.ErrorIs(t, err, ErrNoSpreadSheetID)
+			records, err := ParseSheetIntoStructSlice[contactT](cfg, WithDuplicateColumns(DuplicateColumnsLast))
+			require.NoError(t, err)
+			assert.Equal(t, []contactT{{Name: "foo", Phone: "3"}, {Name: "bar"}}, records)
 		})
 
-		t.Run("error from fetch method", func(t *testing.T) {
+		t.Run("slice", func(t *testing.T) {
 			t.Parallel()
 
-			_, err := ParseSheetIntoStructSlice[allT](
-				Config{Service: _svc},
-				WithSpreadsheetID("foobar"),
-				withFetch(errorFetcher),
-			)
-			assert.ErrorIs(t, err, fetcherError)
+			records, err := ParseSheetIntoStructSlice[contactsT](cfg, WithDuplicateColumns(DuplicateColumnsSlice))
+			require.NoError(t, err)
+			assert.Equal(t, []contactsT{{Name: "foo", Phone: []*int{ptrTo(1), nil, ptrTo(3)}}, {Name: "bar"}}, records)
 		})
 
-		t.Run("unsupported type", func(t *testing.T) {
+		t.Run("slice field without slice mode", func(t *testing.T) {
 			t.Parallel()
 
-			type invalidT struct {
-				Valid   int
-				Invalid complex64
-			}
+			_, err := ParseSheetIntoStructSlice[contactsT](cfg, WithDuplicateColumns(DuplicateColumnsFirst))
+			assert.ErrorIs(t, err, ErrUnsupportedType)
+		})
 
-			_, err := ParseSheetIntoStructSlice[invalidT](
-				Config{Service: _svc},
-				WithSpreadsheetID("invalid"),
-				withFetch(func(cfg Config) (*sheets.ValueRange, error) {
-					assert.Equal(t, "invalid", cfg.spreadsheetID)
-					assert.Equal(t, "invalidTS", cfg.sheetName)
+		t.Run("slice into non-slice field", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[contactT](cfg, WithDuplicateColumns(DuplicateColumnsSlice))
+			assert.ErrorIs(t, err, ErrDuplicateColumn)
+		})
+
+		t.Run("slice conversion error", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[contactsT](cfg,
+				WithDuplicateColumns(DuplicateColumnsSlice),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
 					return &sheets.ValueRange{
 						Values: [][]any{
-							{"Valid", "Invalid"},
-							{"1", "2.432"},
+							{"Name", "Phone", "Phone"},
+							{"foo", "1", "two"},
 						},
 					}, nil
 				}),
 			)
-			assert.ErrorIs(t, err, ErrUnsupportedType)
+			var mappingErr *MappingError
+			require.ErrorAs(t, err, &mappingErr)
+			assert.Equal(t, "C2", mappingErr.Cell)
+			assert.Equal(t, getTypeName[contactsT]()+".Phone[1]", mappingErr.Field)
 		})
+	})
 
-		t.Run("field not found in struct", func(t *testing.T) {
+	t.Run("header matcher", func(t *testing.T) {
+		t.Parallel()
+
+		type userT struct {
+			ID        uint
+			CreatedAt time.Time
+			Name      string `gsheets:"Full Name"`
+		}
+
+		records, err := ParseSheetIntoStructSlice[userT](cfg,
+			WithHeaderMatcher(MatchNormalized),
+			withFetch(func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"id", "Created  At ", "full_name"},
+						{"1", "2024-12-19", "foo"},
+					},
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []userT{
+			{ID: 1, CreatedAt: time.Date(2024, time.December, 19, 0, 0, 0, 0, time.UTC), Name: "foo"},
+		}, records)
+	})
+
+	t.Run("aliases and patterns", func(t *testing.T) {
+		type contactT struct {
+			Email string `gsheets:"Email|E-Mail|Mail address"`
+			Phone int    `gsheets:"re:^(?i)(tel|phone)"`
+		}
+
+		makeFetcher := func(captions ...any) fetchFN {
+			return func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{
+					Values: [][]any{
+						captions,
+						{"foo@example.com", "42"},
+					},
+				}, nil
+			}
+		}
+
+		t.Run("match", func(t *testing.T) {
 			t.Parallel()
 
-			_, err := ParseSheetIntoStructSlice[boolsT](
-				Config{Service: _svc},
-				WithSpreadsheetID("invalid"),
-				WithTagName("sheets"),
-				WithAllowSkipFields(true),
-				withFetch(func(cfg Config) (*sheets.ValueRange, error) {
-					assert.Equal(t, "invalid", cfg.spreadsheetID)
-					assert.Equal(t, "boolsTS", cfg.sheetName)
-					return stringsFetcher(cfg)
-				}),
-			)
-			assert.ErrorIs(t, err, ErrFieldNotFoundInStruct)
+			records, err := ParseSheetIntoStructSlice[contactT](cfg, withFetch(makeFetcher("E-Mail", "Telephone")))
+			require.NoError(t, err)
+			assert.Equal(t, []contactT{{Email: "foo@example.com", Phone: 42}}, records)
 		})
 
-		t.Run("field not found in sheet", func(t *testing.T) {
+		t.Run("matched header in errors", func(t *testing.T) {
 			t.Parallel()
 
-			type invalidT struct {
-				Bools   boolsT
-				Strings stringsT
-			}
-
-			_, err := ParseSheetIntoStructSlice[invalidT](
-				Config{Service: _svc},
-				WithSpreadsheetID("invalid"),
-				WithTagName("sheets"),
-				WithAllowSkipColumns(true),
-				withFetch(func(cfg Config) (*sheets.ValueRange, error) {
-					assert.Equal(t, "invalid", cfg.spreadsheetID)
-					assert.Equal(t, "invalidTS", cfg.sheetName)
-					return stringsFetcher(cfg)
+			_, err := ParseSheetIntoStructSlice[contactT](cfg,
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"Mail address", "PHONE"},
+							{"foo@example.com", "n/a"},
+						},
+					}, nil
 				}),
 			)
-			assert.ErrorIs(t, err, ErrFieldNotFoundInSheet)
+			var mappingErr *MappingError
+			require.ErrorAs(t, err, &mappingErr)
+			assert.Equal(t, "PHONE", mappingErr.Header)
+			assert.Equal(t, "B2", mappingErr.Cell)
 		})
 
-		t.Run("no mappings", func(t *testing.T) {
+		t.Run("aliases in order", func(t *testing.T) {
 			t.Parallel()
 
-			_, err := ParseSheetIntoStructSlice[boolsT](
-				Config{Service: _svc},
-				WithSpreadsheetID("invalid"),
-				WithTagName("sheets"),
-				WithAllowSkipFields(true),
-				WithAllowSkipColumns(true),
-				withFetch(func(cfg Config) (*sheets.ValueRange, error) {
-					assert.Equal(t, "invalid", cfg.spreadsheetID)
-					assert.Equal(t, "boolsTS", cfg.sheetName)
-					return stringsFetcher(cfg)
+			records, err := ParseSheetIntoStructSlice[contactT](cfg, WithAllowSkipColumns(true),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"Mail address", "Phone", "Email"},
+							{"bar@example.com", "42", "foo@example.com"},
+						},
+					}, nil
 				}),
 			)
-			assert.ErrorIs(t, err, ErrNoMapping)
+			require.NoError(t, err)
+			assert.Equal(t, []contactT{{Email: "foo@example.com", Phone: 42}}, records)
 		})
 
-		t.Run("parsing error", func(t *testing.T) {
-			cfg := MakeConfig(_svc, "invalid", WithSheetName("invalid"), withFetch(parseValidationFetcher))
+		t.Run("ambiguous pattern", func(t *testing.T) {
+			t.Parallel()
 
-			t.Run("bool", func(t *testing.T) {
-				t.Parallel()
+			_, err := ParseSheetIntoStructSlice[contactT](cfg, withFetch(makeFetcher("Email", "Phone", "Tel")))
+			var ambiguousErr *AmbiguousColumnError
+			require.ErrorAs(t, err, &ambiguousErr)
+			assert.Equal(t, []string{"Phone", "Tel"}, ambiguousErr.Headers)
+		})
 
-				results, err := ParseSheetIntoStructSlice[boolsT](cfg)
-				assertParseSliceError[boolsT](t, err, reflect.Bool)
-				assert.Nil(t, results)
-			})
+		t.Run("invalid pattern", func(t *testing.T) {
+			t.Parallel()
 
-			t.Run("int", func(t *testing.T) {
-				t.Parallel()
+			type invalidT struct {
+				Phone int `gsheets:"re:(tel"`
+			}
 
-				results, err := ParseSheetIntoStructSlice[intsT](cfg)
-				assertParseSliceError[intsT](t, err, reflect.Int)
-				assert.Nil(t, results)
-			})
+			_, err := ParseSheetIntoStructSlice[invalidT](cfg, withFetch(makeFetcher("Phone")))
+			assert.ErrorIs(t, err, ErrInvalidColumnPattern)
+		})
 
-			t.Run("int8", func(t *testing.T) {
-				t.Parallel()
+		t.Run("escaped separators", func(t *testing.T) {
+			t.Parallel()
 
-				results, err := ParseSheetIntoStructSlice[int8sT](cfg)
-				assertParseSliceError[int8sT](t, err, reflect.Int8)
-				assert.Nil(t, results)
-			})
+			type escapedT struct {
+				Price int    `gsheets:"Price \\| Unit|Price,required"`
+				Code  string `gsheets:"re:^Code\\d{1\\,2}$,default=a\\,b"`
+			}
 
-			t.Run("int16", func(t *testing.T) {
-				t.Parallel()
+			records, err := ParseSheetIntoStructSlice[escapedT](cfg, withFetch(func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"Price | Unit", "Code12"},
+						{"42", ""},
+					},
+				}, nil
+			}))
+			require.NoError(t, err)
+			assert.Equal(t, []escapedT{{Price: 42, Code: "a,b"}}, records)
+		})
+	})
 
-				results, err := ParseSheetIntoStructSlice[int16sT](cfg)
-				assertParseSliceError[int16sT](t, err, reflect.Int16)
-				assert.Nil(t, results)
-			})
+	t.Run("headerless", func(t *testing.T) {
+		type positionalT struct {
+			Name  string `gsheets:",col=A"`
+			Email string `gsheets:",col=C"`
+			Age   int    `gsheets:",col=4"`
+		}
 
-			t.Run("int32", func(t *testing.T) {
-				t.Parallel()
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"foo", "ignored", "foo@example.com", "42"},
+					{"bar", "", "bar@example.com"},
+					{"baz", "", "", "old"},
+				},
+			}, nil
+		})
 
-				results, err := ParseSheetIntoStructSlice[int32sT](cfg)
-				assertParseSliceError[int32sT](t, err, reflect.Int32)
-				assert.Nil(t, results)
-			})
+		t.Run("positional", func(t *testing.T) {
+			t.Parallel()
 
-			t.Run("int64", func(t *testing.T) {
-				t.Parallel()
+			results, err := ParseSheetIntoStructs[positionalT](cfg, WithHeaderless(true), fetcher)
+			require.NoError(t, err)
 
-				results, err := ParseSheetIntoStructSlice[int64sT](cfg)
-				assertParseSliceError[int64sT](t, err, reflect.Int64)
-				assert.Nil(t, results)
-			})
+			next, stop := iter.Pull2(results)
+			defer stop()
 
-			t.Run("uint", func(t *testing.T) {
-				t.Parallel()
+			r, item, _ := next()
+			require.NoError(t, item.Err)
+			assert.Equal(t, 1, r)
+			assert.Equal(t, positionalT{Name: "foo", Email: "foo@example.com", Age: 42}, item.Val)
 
-				results, err := ParseSheetIntoStructSlice[uintsT](cfg)
-				assertParseSliceError[uintsT](t, err, reflect.Uint)
-				assert.Nil(t, results)
-			})
+			r, item, _ = next()
+			require.NoError(t, item.Err)
+			assert.Equal(t, 2, r)
+			assert.Equal(t, positionalT{Name: "bar", Email: "bar@example.com"}, item.Val)
+
+			r, item, _ = next()
+			var mappingErr *MappingError
+			require.ErrorAs(t, item.Err, &mappingErr)
+			assert.Equal(t, 3, r)
+			assert.Equal(t, "D3", mappingErr.Cell)
+		})
 
-			t.Run("uint8", func(t *testing.T) {
-				t.Parallel()
+		t.Run("column beyond data", func(t *testing.T) {
+			t.Parallel()
 
-				results, err := ParseSheetIntoStructSlice[uint8sT](cfg)
-				assertParseSliceError[uint8sT](t, err, reflect.Uint8)
-				assert.Nil(t, results)
-			})
+			type wideT struct {
+				Name string `gsheets:",col=A"`
+				Note string `gsheets:",col=AA"`
+			}
 
-			t.Run("uint16", func(t *testing.T) {
-				t.Parallel()
+			records, err := ParseSheetIntoStructSlice[wideT](cfg, WithHeaderless(true), fetcher)
+			require.NoError(t, err)
+			assert.Equal(t, []wideT{{Name: "foo"}, {Name: "bar"}, {Name: "baz"}}, records)
+		})
 
-				results, err := ParseSheetIntoStructSlice[uint16sT](cfg)
-				assertParseSliceError[uint16sT](t, err, reflect.Uint16)
-				assert.Nil(t, results)
+		t.Run("missing column", func(t *testing.T) {
+			t.Parallel()
+
+			type missingT struct {
+				Name  string `gsheets:",col=A"`
+				Email string
+			}
+
+			_, err := ParseSheetIntoStructs[missingT](cfg, WithHeaderless(true), fetcher)
+			assert.ErrorIs(t, err, ErrFieldNotFoundInSheet)
+		})
+
+		t.Run("invalid column", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				Name string `gsheets:",col=0"`
+			}
+
+			_, err := ParseSheetIntoStructs[invalidT](cfg, WithHeaderless(true), fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+		})
+
+		t.Run("pinned column with header", func(t *testing.T) {
+			t.Parallel()
+
+			type pinnedT struct {
+				Name  string
+				Email string `gsheets:",col=C"`
+			}
+
+			records, err := ParseSheetIntoStructSlice[pinnedT](cfg,
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"Name", "Mail", "Mail"},
+							{"foo", "", "foo@example.com"},
+						},
+					}, nil
+				}),
+				WithAllowSkipColumns(true),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, []pinnedT{{Name: "foo", Email: "foo@example.com"}}, records)
+		})
+	})
+
+	t.Run("blank headers", func(t *testing.T) {
+		type spacedT struct {
+			Name  string
+			Email string
+			Age   int
+		}
+
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"Name", "", "Email", "Age", "", "Notes"},
+					{"foo", "", "foo@example.com", "42", "", "bar"},
+				},
+			}, nil
+		})
+
+		t.Run("stop at first blank header", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructs[spacedT](cfg, fetcher)
+			assert.ErrorIs(t, err, ErrFieldNotFoundInSheet)
+		})
+
+		t.Run("skip blank headers", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructs[spacedT](cfg, fetcher, WithSkipBlankHeaders(true))
+			var mismatchErr *SchemaMismatchError
+			require.ErrorAs(t, err, &mismatchErr)
+			assert.Empty(t, mismatchErr.MissingFields)
+			assert.Equal(t, []UnknownColumn{{Name: "Notes", Column: "F", Index: 5}}, mismatchErr.UnknownColumns)
+		})
+
+		t.Run("skip blank headers with table width", func(t *testing.T) {
+			t.Parallel()
+
+			records, err := ParseSheetIntoStructSlice[spacedT](cfg, fetcher,
+				WithSkipBlankHeaders(true),
+				WithTableEnd(TableEndWidth(4)),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, []spacedT{{Name: "foo", Email: "foo@example.com", Age: 42}}, records)
+		})
+
+		t.Run("skip blank headers until first blank", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructs[spacedT](cfg, fetcher,
+				WithSkipBlankHeaders(true),
+				WithTableEnd(TableEndFirstBlank),
+			)
+			assert.ErrorIs(t, err, ErrFieldNotFoundInSheet)
+		})
+
+		t.Run("negative table width", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructs[spacedT](cfg, fetcher, WithTableEnd(TableEndWidth(-1)))
+			assert.ErrorIs(t, err, ErrFieldNotFoundInSheet)
+		})
+	})
+
+	t.Run("grouped headers", func(t *testing.T) {
+		type addressT struct {
+			Street string
+			City   string
+			Zip    int
+		}
+		type Meta struct {
+			Notes string
+		}
+		type orderT struct {
+			ID       int
+			Billing  addressT
+			Shipping *addressT `gsheets:"Shipping Address"`
+			Meta
+		}
+
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"ID", "Billing", "", "", "Shipping Address", "", "", "Notes"},
+					{"", "Street", "City", "Zip", "Street", "City", "Zip"},
+					{"1", "Main St", "Springfield", "12345", "Side St", "Shelbyville", "54321", "fragile"},
+					{"2", "Elm St", "Capital City", "23456"},
+					{"3", "", "", "", "Oak St", "Ogdenville", "zip"},
+				},
+			}, nil
+		})
+
+		t.Run("nested structs", func(t *testing.T) {
+			t.Parallel()
+
+			results, err := ParseSheetIntoStructs[orderT](cfg, fetcher, WithHeaderRows(2))
+			require.NoError(t, err)
+
+			records := make([]orderT, 0, 2)
+			for r, item := range results {
+				if r == 5 {
+					var mappingErr *MappingError
+					require.ErrorAs(t, item.Err, &mappingErr)
+					assert.Equal(t, "G5", mappingErr.Cell)
+					assert.Equal(t, "Shipping Address / Zip", mappingErr.Header)
+					continue
+				}
+				require.NoError(t, item.Err)
+				records = append(records, item.Val)
+			}
+
+			assert.Equal(t, []orderT{
+				{
+					ID:       1,
+					Billing:  addressT{Street: "Main St", City: "Springfield", Zip: 12345},
+					Shipping: &addressT{Street: "Side St", City: "Shelbyville", Zip: 54321},
+					Meta:     Meta{Notes: "fragile"},
+				},
+				{
+					ID:      2,
+					Billing: addressT{Street: "Elm St", City: "Capital City", Zip: 23456},
+				},
+			}, records)
+		})
+
+		t.Run("missing group", func(t *testing.T) {
+			t.Parallel()
+
+			type invoiceT struct {
+				ID      int
+				Billing addressT
+				Invoice addressT
+			}
+
+			_, err := ParseSheetIntoStructs[invoiceT](cfg, fetcher, WithHeaderRows(2), WithAllowSkipColumns(true))
+			var mismatchErr *SchemaMismatchError
+			require.ErrorAs(t, err, &mismatchErr)
+			assert.Equal(t, []string{"Invoice / Street", "Invoice / City", "Invoice / Zip"}, []string{
+				mismatchErr.MissingFields[0].Name,
+				mismatchErr.MissingFields[1].Name,
+				mismatchErr.MissingFields[2].Name,
+			})
+		})
+	})
+
+	t.Run("prefixed nested structs", func(t *testing.T) {
+		t.Parallel()
+
+		type geoT struct {
+			Lat float64 `gsheets:"re:^(?i)lat(itude)?$"`
+		}
+		type addressT struct {
+			Street string
+			City   string `gsheets:"City|Town"`
+			Geo    geoT   `gsheets:",prefix=Geo "`
+		}
+		type orderT struct {
+			ID       int
+			Billing  addressT  `gsheets:",prefix=Billing "`
+			Shipping *addressT `gsheets:",prefix=Shipping "`
+		}
+
+		records, err := ParseSheetIntoStructSlice[orderT](cfg,
+			withFetch(func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"ID", "Billing Street", "Billing Town", "Billing Geo Latitude", "Shipping Street", "Shipping City", "Shipping Geo lat"},
+						{"1", "Main St", "Springfield", "1.5", "Side St", "Shelbyville", "2.5"},
+						{"2", "Elm St", "Capital City"},
+					},
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []orderT{
+			{
+				ID:       1,
+				Billing:  addressT{Street: "Main St", City: "Springfield", Geo: geoT{Lat: 1.5}},
+				Shipping: &addressT{Street: "Side St", City: "Shelbyville", Geo: geoT{Lat: 2.5}},
+			},
+			{
+				ID:      2,
+				Billing: addressT{Street: "Elm St", City: "Capital City"},
+			},
+		}, records)
+	})
+
+	t.Run("major dimension columns", func(t *testing.T) {
+		t.Parallel()
+
+		type productT struct {
+			SKU   string
+			Price float64
+		}
+
+		results, err := ParseSheetIntoStructs[productT](cfg,
+			WithMajorDimension(MajorDimensionColumns),
+			withFetch(func(cfg Config) (*sheets.ValueRange, error) {
+				assert.Equal(t, MajorDimensionColumns, cfg.majorDimension)
+				return &sheets.ValueRange{
+					MajorDimension: "COLUMNS",
+					Values: [][]any{
+						{"SKU", "Price"},
+						{"foo", "1.5"},
+						{"bar", "free"},
+					},
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+
+		next, stop := iter.Pull2(results)
+		defer stop()
+
+		c, item, _ := next()
+		require.NoError(t, item.Err)
+		assert.Equal(t, 2, c)
+		assert.Equal(t, productT{SKU: "foo", Price: 1.5}, item.Val)
+
+		c, item, _ = next()
+		var mappingErr *MappingError
+		require.ErrorAs(t, item.Err, &mappingErr)
+		assert.Equal(t, 3, c)
+		assert.Equal(t, "C2", mappingErr.Cell)
+	})
+
+	t.Run("stop options", func(t *testing.T) {
+		type productT struct {
+			SKU   string
+			Price float64
+		}
+
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"SKU", "Price"},
+					{"foo", "1.5"},
+					{"bar", "2"},
+					{"Total", "3.5"},
+					{},
+					{"Prices are subject to change"},
+				},
+			}, nil
+		})
+
+		collect := func(t *testing.T, opts ...ConfigOption) []int {
+			results, err := ParseSheetIntoStructs[productT](cfg, append(opts, fetcher)...)
+			require.NoError(t, err)
+
+			var rows []int
+			for rowIdx, item := range results {
+				require.NoError(t, item.Err)
+				rows = append(rows, rowIdx)
+			}
+			return rows
+		}
+
+		t.Run("blank row", func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, []int{2, 3, 4}, collect(t, WithStopAtBlankRow(true)))
+		})
+
+		t.Run("predicate", func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, []int{2, 3}, collect(t, WithStopAt(FirstCellEquals("Total"))))
+			assert.Equal(t, []int{2}, collect(t, WithStopAt(func(rowIdx int, cells []string) bool {
+				return rowIdx == 3 && cells[0] == "bar"
+			})))
+		})
+
+		t.Run("max rows", func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, []int{2}, collect(t, WithMaxRows(1)))
+			assert.Equal(t, []int{2, 3}, collect(t, WithMaxRows(3), WithStopAt(FirstCellEquals("Total"))))
+		})
+	})
+
+	t.Run("skip rows", func(t *testing.T) {
+		type productT struct {
+			SKU   string
+			Price float64 `gsheets:",required"`
+		}
+
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"SKU", "Price"},
+					{"foo", "1.5"},
+					{},
+					{"# discontinued"},
+					{"bar", "2"},
+					{"", ""},
+					{"baz", "0"},
+				},
+			}, nil
+		})
+
+		t.Run("blank rows by default", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[productT](cfg, fetcher)
+			var mappingErr *MappingError
+			require.ErrorAs(t, err, &mappingErr)
+			assert.Equal(t, "B4", mappingErr.Cell)
+		})
+
+		t.Run("keep blank rows", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[productT](cfg, fetcher, WithSkipBlankRows(false), WithCommentPrefix("#"))
+			var mappingErr *MappingError
+			require.ErrorAs(t, err, &mappingErr)
+			assert.Equal(t, "B3", mappingErr.Cell)
+			assert.ErrorIs(t, err, ErrRequiredValue)
+		})
+
+		t.Run("comments and filter", func(t *testing.T) {
+			t.Parallel()
+
+			var filtered []int
+			products, err := ParseSheetIntoStructSlice[productT](cfg, fetcher,
+				WithCommentPrefix("#"),
+				WithRowFilter(func(rowIdx int, cells []string) bool {
+					filtered = append(filtered, rowIdx)
+					return cells[1] != "0"
+				}),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, []productT{{SKU: "foo", Price: 1.5}, {SKU: "bar", Price: 2}}, products)
+			assert.Equal(t, []int{2, 5, 7}, filtered)
+		})
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"SKU", "Stock"},
+					{"foo", ""},
+					{"bar", ""},
+				},
+			}, nil
+		})
+
+		t.Run("copied per row", func(t *testing.T) {
+			t.Parallel()
+
+			type productT struct {
+				SKU   string
+				Stock *int `gsheets:",default=1"`
+			}
+
+			products, err := ParseSheetIntoStructSlice[productT](cfg, fetcher)
+			require.NoError(t, err)
+			require.Len(t, products, 2)
+			assert.Equal(t, 1, *products[0].Stock)
+			assert.NotSame(t, products[0].Stock, products[1].Stock)
+		})
+
+		t.Run("invalid default", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				SKU   string
+				Stock int `gsheets:",default=abc"`
+			}
+
+			_, err := ParseSheetIntoStructSlice[invalidT](cfg, fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+		})
+	})
+
+	t.Run("metadata", func(t *testing.T) {
+		type sourceT struct {
+			Sheet       string `gsheets:",sheet"`
+			Spreadsheet string `gsheets:",spreadsheet"`
+		}
+		type productT struct {
+			Row    int `gsheets:",rownum"`
+			SKU    string
+			Source *sourceT
+		}
+
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"SKU"},
+					{"foo"},
+					{},
+					{"bar"},
+				},
+			}, nil
+		})
+
+		t.Run("inject", func(t *testing.T) {
+			t.Parallel()
+
+			products, err := ParseSheetIntoStructSlice[productT](cfg, fetcher)
+			require.NoError(t, err)
+			source := &sourceT{Sheet: "test-sheet", Spreadsheet: "test-workbook"}
+			assert.Equal(t, []productT{{Row: 2, SKU: "foo", Source: source}, {Row: 4, SKU: "bar", Source: source}}, products)
+		})
+
+		t.Run("unsigned row number", func(t *testing.T) {
+			t.Parallel()
+
+			type unsignedT struct {
+				Row uint64 `gsheets:",rownum"`
+				SKU string
+			}
+
+			products, err := ParseSheetIntoStructSlice[unsignedT](cfg, fetcher)
+			require.NoError(t, err)
+			assert.Equal(t, []unsignedT{{Row: 2, SKU: "foo"}, {Row: 4, SKU: "bar"}}, products)
+		})
+
+		t.Run("invalid type", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				Row string `gsheets:",rownum"`
+				SKU string
+			}
+			type overflowT struct {
+				Row int16 `gsheets:",rownum"`
+				SKU string
+			}
+
+			_, err := ParseSheetIntoStructSlice[invalidT](cfg, fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+			_, err = ParseSheetIntoStructSlice[overflowT](cfg, fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+		})
+
+		t.Run("no mapping", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[sourceT](cfg, fetcher, WithAllowSkipColumns(true))
+			assert.ErrorIs(t, err, ErrNoMapping)
+		})
+	})
+
+	t.Run("raw cells", func(t *testing.T) {
+		type productT struct {
+			SKU     string
+			Price   float64
+			Raw     []string          `gsheets:",raw"`
+			Cells   map[string]string `gsheets:",raw"`
+			Fetched []any             `gsheets:",raw"`
+		}
+
+		t.Run("with header", func(t *testing.T) {
+			t.Parallel()
+
+			products, err := ParseSheetIntoStructSlice[productT](cfg,
+				WithSkipBlankHeaders(true),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"SKU", "", "Price"},
+							{"foo", "note", "1.50"},
+							{"bar"},
+						},
+					}, nil
+				}),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, []productT{
+				{
+					SKU:     "foo",
+					Price:   1.5,
+					Raw:     []string{"foo", "note", "1.50"},
+					Cells:   map[string]string{"SKU": "foo", "Price": "1.50"},
+					Fetched: []any{"foo", "note", "1.50"},
+				},
+				{SKU: "bar", Raw: []string{"bar"}, Cells: map[string]string{"SKU": "bar"}, Fetched: []any{"bar"}},
+			}, products)
+		})
+
+		t.Run("headerless", func(t *testing.T) {
+			t.Parallel()
+
+			type positionalT struct {
+				SKU   string            `gsheets:",col=A"`
+				Cells map[string]string `gsheets:",raw"`
+			}
+
+			products, err := ParseSheetIntoStructSlice[positionalT](cfg,
+				WithHeaderless(true),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{Values: [][]any{{"foo", "1.50"}}}, nil
+				}),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, []positionalT{{SKU: "foo", Cells: map[string]string{"A": "foo", "B": "1.50"}}}, products)
+		})
+
+		t.Run("invalid type", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				SKU string
+				Raw []int `gsheets:",raw"`
+			}
+
+			_, err := ParseSheetIntoStructSlice[invalidT](cfg, withFetch(stringsFetcher))
+			assert.ErrorIs(t, err, ErrInvalidTag)
+		})
+	})
+
+	t.Run("fill down", func(t *testing.T) {
+		type productT struct {
+			Category string `gsheets:",filldown"`
+			Group    string
+			SKU      string
+		}
+
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"Category", "Group", "SKU"},
+					{"Fruits", "Apples", "foo"},
+					{"", "", "bar"},
+					{"", "Pears", "baz"},
+					{"Vegetables", "", "qux"},
+				},
+			}, nil
+		})
+
+		t.Run("tag", func(t *testing.T) {
+			t.Parallel()
+
+			products, err := ParseSheetIntoStructSlice[productT](cfg, fetcher)
+			require.NoError(t, err)
+			assert.Equal(t, []productT{
+				{Category: "Fruits", Group: "Apples", SKU: "foo"},
+				{Category: "Fruits", SKU: "bar"},
+				{Category: "Fruits", Group: "Pears", SKU: "baz"},
+				{Category: "Vegetables", SKU: "qux"},
+			}, products)
+		})
+
+		t.Run("sheet", func(t *testing.T) {
+			t.Parallel()
+
+			products, err := ParseSheetIntoStructSlice[productT](cfg, fetcher, WithFillDown(true))
+			require.NoError(t, err)
+			assert.Equal(t, []productT{
+				{Category: "Fruits", Group: "Apples", SKU: "foo"},
+				{Category: "Fruits", Group: "Apples", SKU: "bar"},
+				{Category: "Fruits", Group: "Pears", SKU: "baz"},
+				{Category: "Vegetables", Group: "Pears", SKU: "qux"},
+			}, products)
+		})
+
+		t.Run("merges", func(t *testing.T) {
+			t.Parallel()
+
+			products, err := ParseSheetIntoStructSlice[productT](cfg, fetcher,
+				WithFillDownMerges(true),
+				withFetchMerges(func(cfg Config) ([]*sheets.GridRange, error) {
+					assert.Equal(t, "test-sheet", cfg.sheetName)
+					return []*sheets.GridRange{{StartRowIndex: 1, EndRowIndex: 3, StartColumnIndex: 1, EndColumnIndex: 2}}, nil
+				}),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, []productT{
+				{Category: "Fruits", Group: "Apples", SKU: "foo"},
+				{Category: "Fruits", Group: "Apples", SKU: "bar"},
+				{Category: "Fruits", Group: "Pears", SKU: "baz"},
+				{Category: "Vegetables", SKU: "qux"},
+			}, products)
+		})
+
+		t.Run("merges across columns", func(t *testing.T) {
+			t.Parallel()
+
+			type cellT struct {
+				ID    int
+				Left  string
+				Right string
+			}
+
+			cells, err := ParseSheetIntoStructSlice[cellT](cfg,
+				WithFillDownMerges(true),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"ID", "Left", "Right"},
+							{"1", "a", "b"},
+							{"2", "x"},
+							{"3"},
+						},
+					}, nil
+				}),
+				withFetchMerges(func(Config) ([]*sheets.GridRange, error) {
+					// B3:C4
+					return []*sheets.GridRange{{StartRowIndex: 2, EndRowIndex: 4, StartColumnIndex: 1, EndColumnIndex: 3}}, nil
+				}),
+			)
+			require.NoError(t, err)
+			assert.Equal(t, []cellT{
+				{ID: 1, Left: "a", Right: "b"},
+				{ID: 2, Left: "x", Right: "x"},
+				{ID: 3, Left: "x", Right: "x"},
+			}, cells)
+		})
+
+		t.Run("error from fetch merges method", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[productT](cfg, fetcher,
+				WithFillDownMerges(true),
+				withFetchMerges(func(Config) ([]*sheets.GridRange, error) {
+					return nil, fetcherError
+				}),
+			)
+			assert.ErrorIs(t, err, fetcherError)
+		})
+	})
+
+	t.Run("unpivot", func(t *testing.T) {
+		type forecastT struct {
+			SKU   string
+			Month time.Time `gsheets:"re:^\\d{4}-\\d{2}$,unpivot"`
+			Units int       `gsheets:",unpivotvalue"`
+		}
+
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"SKU", "2026-01", "2026-02", "2026-03"},
+					{"foo", "10", "", "30"},
+					{"bar", "5", "many"},
+				},
+			}, nil
+		})
+		month := func(m time.Month) time.Time {
+			return time.Date(2026, m, 1, 0, 0, 0, 0, time.UTC)
+		}
+
+		t.Run("records", func(t *testing.T) {
+			t.Parallel()
+
+			results, err := ParseSheetIntoStructs[forecastT](cfg, fetcher, WithDatetimeFormats("2006-01"))
+			require.NoError(t, err)
+
+			var rows []int
+			var forecasts []forecastT
+			for rowIdx, item := range results {
+				rows = append(rows, rowIdx)
+				if item.Err != nil {
+					var mappingErr *MappingError
+					require.ErrorAs(t, item.Err, &mappingErr)
+					assert.Equal(t, "C3", mappingErr.Cell)
+					continue
+				}
+				forecasts = append(forecasts, item.Val)
+			}
+
+			assert.Equal(t, []int{2, 2, 3, 3}, rows)
+			assert.Equal(t, []forecastT{
+				{SKU: "foo", Month: month(time.January), Units: 10},
+				{SKU: "foo", Month: month(time.March), Units: 30},
+				{SKU: "bar", Month: month(time.January), Units: 5},
+			}, forecasts)
+		})
+
+		t.Run("invalid header", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructs[forecastT](cfg, fetcher)
+			var mappingErr *MappingError
+			require.ErrorAs(t, err, &mappingErr)
+			assert.Equal(t, "B1", mappingErr.Cell)
+			assert.Equal(t, "2026-01", mappingErr.Value)
+		})
+
+		t.Run("missing value field", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				SKU   string
+				Month string `gsheets:"re:^\\d{4}-\\d{2}$,unpivot"`
+			}
+
+			_, err := ParseSheetIntoStructs[invalidT](cfg, fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+		})
+
+		t.Run("no unpivoted columns", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructs[forecastT](cfg, WithAllowSkipFields(true), WithAllowSkipColumns(true),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"SKU", "Jan", "Feb"},
+							{"foo", "10", "20"},
+						},
+					}, nil
+				}),
+			)
+			var mismatchErr *SchemaMismatchError
+			require.ErrorAs(t, err, &mismatchErr)
+			assert.Equal(t, []MissingField{{Field: getTypeName[forecastT]() + ".Month", Name: `re:^\d{4}-\d{2}$`}}, mismatchErr.MissingFields)
+		})
+
+		t.Run("multiple types", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoUnions(cfg, "SKU", map[string]any{"foo": forecastT{}}, fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+
+			type groupT struct {
+				SKU   string      `gsheets:",groupkey"`
+				Items []forecastT `gsheets:",children"`
+			}
+
+			_, err = ParseSheetIntoGroups[groupT](cfg, fetcher)
+			assert.ErrorIs(t, err, ErrInvalidTag)
+		})
+	})
+
+	t.Run("stop iter loop", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := ParseSheetIntoStructs[stringsT](cfg,
+			WithTagName("sheets"),
+			withFetch(stringsFetcher),
+		)
+		require.NoError(t, err)
+
+		records := make([]stringsT, 0, 2)
+		var i int
+		for _, item := range results {
+			records = append(records, item.Val)
+			if i++; i == 2 {
+				break
+			}
+		}
+
+		assert.Len(t, records, 2)
+	})
+}
+
+func TestParseSheetIntoStructSlice(t *testing.T) {
+	t.Run("errors", func(t *testing.T) {
+		t.Run("no service", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[allT](Config{}, withFetch(errorFetcher))
+			assert.ErrorIs(t, err, ErrNoService)
+		})
+
+		t.Run("no spreadsheet id", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[allT](Config{Service: _svc}, withFetch(errorFetcher))
+			assert.ErrorIs(t, err, ErrNoSpreadSheetID)
+		})
+
+		t.Run("error from fetch method", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[allT](
+				Config{Service: _svc},
+				WithSpreadsheetID("foobar"),
+				withFetch(errorFetcher),
+			)
+			assert.ErrorIs(t, err, fetcherError)
+		})
+
+		t.Run("unsupported type", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				Valid   int
+				Invalid complex64
+			}
+
+			_, err := ParseSheetIntoStructSlice[invalidT](
+				Config{Service: _svc},
+				WithSpreadsheetID("invalid"),
+				withFetch(func(cfg Config) (*sheets.ValueRange, error) {
+					assert.Equal(t, "invalid", cfg.spreadsheetID)
+					assert.Equal(t, "invalidTS", cfg.sheetName)
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"Valid", "Invalid"},
+							{"1", "2.432"},
+						},
+					}, nil
+				}),
+			)
+			assert.ErrorIs(t, err, ErrUnsupportedType)
+		})
+
+		t.Run("field not found in struct", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[boolsT](
+				Config{Service: _svc},
+				WithSpreadsheetID("invalid"),
+				WithTagName("sheets"),
+				WithAllowSkipFields(true),
+				withFetch(func(cfg Config) (*sheets.ValueRange, error) {
+					assert.Equal(t, "invalid", cfg.spreadsheetID)
+					assert.Equal(t, "boolsTS", cfg.sheetName)
+					return stringsFetcher(cfg)
+				}),
+			)
+			assert.ErrorIs(t, err, ErrFieldNotFoundInStruct)
+		})
+
+		t.Run("field not found in sheet", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				Bools   boolsT
+				Strings stringsT
+			}
+
+			_, err := ParseSheetIntoStructSlice[invalidT](
+				Config{Service: _svc},
+				WithSpreadsheetID("invalid"),
+				WithTagName("sheets"),
+				WithAllowSkipColumns(true),
+				withFetch(func(cfg Config) (*sheets.ValueRange, error) {
+					assert.Equal(t, "invalid", cfg.spreadsheetID)
+					assert.Equal(t, "invalidTS", cfg.sheetName)
+					return stringsFetcher(cfg)
+				}),
+			)
+			assert.ErrorIs(t, err, ErrFieldNotFoundInSheet)
+		})
+
+		t.Run("no mappings", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStructSlice[boolsT](
+				Config{Service: _svc},
+				WithSpreadsheetID("invalid"),
+				WithTagName("sheets"),
+				WithAllowSkipFields(true),
+				WithAllowSkipColumns(true),
+				withFetch(func(cfg Config) (*sheets.ValueRange, error) {
+					assert.Equal(t, "invalid", cfg.spreadsheetID)
+					assert.Equal(t, "boolsTS", cfg.sheetName)
+					return stringsFetcher(cfg)
+				}),
+			)
+			assert.ErrorIs(t, err, ErrNoMapping)
+		})
+
+		t.Run("parsing error", func(t *testing.T) {
+			cfg := MakeConfig(_svc, "invalid", WithSheetName("invalid"), withFetch(parseValidationFetcher))
+
+			t.Run("bool", func(t *testing.T) {
+				t.Parallel()
+
+				results, err := ParseSheetIntoStructSlice[boolsT](cfg)
+				assertParseSliceError[boolsT](t, err, reflect.Bool)
+				assert.Nil(t, results)
+			})
+
+			t.Run("int", func(t *testing.T) {
+				t.Parallel()
+
+				results, err := ParseSheetIntoStructSlice[intsT](cfg)
+				assertParseSliceError[intsT](t, err, reflect.Int)
+				assert.Nil(t, results)
+			})
+
+			t.Run("int8", func(t *testing.T) {
+				t.Parallel()
+
+				results, err := ParseSheetIntoStructSlice[int8sT](cfg)
+				assertParseSliceError[int8sT](t, err, reflect.Int8)
+				assert.Nil(t, results)
+			})
+
+			t.Run("int16", func(t *testing.T) {
+				t.Parallel()
+
+				results, err := ParseSheetIntoStructSlice[int16sT](cfg)
+				assertParseSliceError[int16sT](t, err, reflect.Int16)
+				assert.Nil(t, results)
+			})
+
+			t.Run("int32", func(t *testing.T) {
+				t.Parallel()
+
+				results, err := ParseSheetIntoStructSlice[int32sT](cfg)
+				assertParseSliceError[int32sT](t, err, reflect.Int32)
+				assert.Nil(t, results)
+			})
+
+			t.Run("int64", func(t *testing.T) {
+				t.Parallel()
+
+				results, err := ParseSheetIntoStructSlice[int64sT](cfg)
+				assertParseSliceError[int64sT](t, err, reflect.Int64)
+				assert.Nil(t, results)
+			})
+
+			t.Run("uint", func(t *testing.T) {
+				t.Parallel()
+
+				results, err := ParseSheetIntoStructSlice[uintsT](cfg)
+				assertParseSliceError[uintsT](t, err, reflect.Uint)
+				assert.Nil(t, results)
+			})
+
+			t.Run("uint8", func(t *testing.T) {
+				t.Parallel()
+
+				results, err := ParseSheetIntoStructSlice[uint8sT](cfg)
+				assertParseSliceError[uint8sT](t, err, reflect.Uint8)
+				assert.Nil(t, results)
+			})
+
+			t.Run("uint16", func(t *testing.T) {
+				t.Parallel()
+
+				results, err := ParseSheetIntoStructSlice[uint16sT](cfg)
+				assertParseSliceError[uint16sT](t, err, reflect.Uint16)
+				assert.Nil(t, results)
 			})
 
 			t.Run("uint32", func(t *testing.T) {
@@ -596,6 +1742,96 @@ func TestParseSheetIntoStructSlice(t *testing.T) {
 				assert.Nil(t, results)
 			})
 		})
+
+		t.Run("error budget", func(t *testing.T) {
+			cfg := MakeConfig(_svc, "invalid", WithSheetName("invalid"), WithTagName("sheets"), WithAllowSkipFields(true),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"intsT_value"},
+							{"1"}, {"foo"}, {"3"}, {"bar"}, {"5"}, {"6"}, {"7"}, {"8"}, {"9"}, {"10"},
+						},
+					}, nil
+				}),
+			)
+
+			t.Run("max errors within budget", func(t *testing.T) {
+				t.Parallel()
+
+				records, err := ParseSheetIntoStructSlice[intsT](cfg, WithMaxErrors(2))
+				assert.Len(t, records, 8)
+
+				var skippedErr *SkippedRowsError
+				require.ErrorAs(t, err, &skippedErr)
+				assert.ErrorIs(t, err, ErrRowsSkipped)
+				assert.Equal(t, "invalid", skippedErr.Sheet)
+				assert.Equal(t, 10, skippedErr.Rows)
+				assert.Len(t, skippedErr.Unwrap(), 2)
+			})
+
+			t.Run("max errors exceeded", func(t *testing.T) {
+				t.Parallel()
+
+				records, err := ParseSheetIntoStructSlice[intsT](cfg, WithMaxErrors(1))
+				assert.Nil(t, records)
+
+				var budgetErr *ErrorBudgetError
+				require.ErrorAs(t, err, &budgetErr)
+				assert.ErrorIs(t, err, ErrErrorBudgetExceeded)
+				assert.Equal(t, "invalid", budgetErr.Sheet)
+				assert.Equal(t, 4, budgetErr.Rows)
+				assert.Equal(t, 1, budgetErr.Allowed)
+				assert.Len(t, budgetErr.Unwrap(), 2)
+
+				var mappingErr *MappingError
+				require.ErrorAs(t, err, &mappingErr)
+				assert.Equal(t, "A3", mappingErr.Cell)
+			})
+
+			t.Run("max error ratio within budget", func(t *testing.T) {
+				t.Parallel()
+
+				records, err := ParseSheetIntoStructSlice[intsT](cfg, WithMaxErrorRatio(0.2))
+				assert.ErrorIs(t, err, ErrRowsSkipped)
+				assert.Len(t, records, 8)
+			})
+
+			t.Run("max error ratio of converted rows", func(t *testing.T) {
+				t.Parallel()
+
+				records, err := ParseSheetIntoStructSlice[intsT](cfg, WithMaxErrorRatio(0.2),
+					withFetch(func(Config) (*sheets.ValueRange, error) {
+						return &sheets.ValueRange{
+							Values: [][]any{{"intsT_value"}, {"foo"}, {"1"}, {}, {}, {}, {}, {}, {}, {}, {}},
+						}, nil
+					}),
+				)
+				assert.Nil(t, records)
+
+				var budgetErr *ErrorBudgetError
+				require.ErrorAs(t, err, &budgetErr)
+				assert.Equal(t, 2, budgetErr.Rows)
+				assert.Equal(t, 0, budgetErr.Allowed)
+			})
+
+			t.Run("max error ratio exceeded", func(t *testing.T) {
+				t.Parallel()
+
+				records, err := ParseSheetIntoStructSlice[intsT](cfg, WithMaxErrorRatio(0.1))
+				assert.Nil(t, records)
+				assert.ErrorIs(t, err, ErrErrorBudgetExceeded)
+			})
+
+			t.Run("report", func(t *testing.T) {
+				t.Parallel()
+
+				records, report, err := ParseSheetWithReport[intsT](cfg, WithMaxErrors(1))
+				assert.Nil(t, records)
+				assert.ErrorIs(t, err, ErrErrorBudgetExceeded)
+				require.NotNil(t, report)
+				assert.Len(t, report.Issues, 2)
+			})
+		})
 	})
 
 	cfg := MakeConfig(_svc, "test-workbook", WithSheetName("test-sheet"), WithDatetimeFormats("2.1.2006"))
@@ -639,6 +1875,7 @@ func TestParseSheetIntoStructSlice(t *testing.T) {
 					WithTagName("sheets"),
 					WithAllowSkipColumns(true),
 					WithAllowSkipFields(true),
+					WithSkipBlankRows(false),
 					withFetch(func(cfg Config) (*sheets.ValueRange, error) {
 						assert.Equal(t, "test-workbook", cfg.spreadsheetID)
 						assert.Equal(t, "test-sheet", cfg.sheetName)
@@ -654,6 +1891,207 @@ func TestParseSheetIntoStructSlice(t *testing.T) {
 	})
 }
 
+func TestParseSheetIntoStruct(t *testing.T) {
+	type settingsT struct {
+		FeatureX bool
+		Price    float64 `gsheets:"price"`
+		Currency string  `gsheets:"currency,default=EUR"`
+		Discount *int    `gsheets:",default=0"`
+		Owner    string  `gsheets:",required"`
+	}
+
+	cfg := MakeConfig(_svc, "test-workbook", WithSheetName("settings"))
+
+	t.Run("errors", func(t *testing.T) {
+		t.Run("error from fetch method", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStruct[settingsT](cfg, withFetch(errorFetcher))
+			assert.ErrorIs(t, err, fetcherError)
+		})
+
+		t.Run("field not found in sheet", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStruct[settingsT](cfg,
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"Key", "FeatureX", "Owner"},
+							{"Value", "true", "foo"},
+						},
+					}, nil
+				}),
+			)
+			var mismatchErr *SchemaMismatchError
+			require.ErrorAs(t, err, &mismatchErr)
+			assert.Equal(t, []MissingField{{Field: getTypeName[settingsT]() + ".Price", Name: "price"}}, mismatchErr.MissingFields)
+		})
+
+		t.Run("parsing error", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStruct[settingsT](cfg,
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"Key", "FeatureX", "price", "Owner"},
+							{"Value", "true", "free", "foo"},
+						},
+					}, nil
+				}),
+			)
+			var mappingErr *MappingError
+			require.ErrorAs(t, err, &mappingErr)
+			assert.Equal(t, "B3", mappingErr.Cell)
+			assert.Equal(t, "free", mappingErr.Value)
+		})
+
+		t.Run("required value", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStruct[settingsT](cfg,
+				WithHeaderless(true),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{
+						Values: [][]any{
+							{"FeatureX", "price", "Owner"},
+							{"true", "1.5"},
+						},
+					}, nil
+				}),
+			)
+			var mappingErr *MappingError
+			require.ErrorAs(t, err, &mappingErr)
+			assert.ErrorIs(t, err, ErrRequiredValue)
+			assert.Equal(t, "B3", mappingErr.Cell)
+		})
+	})
+
+	t.Run("with header", func(t *testing.T) {
+		t.Parallel()
+
+		settings, err := ParseSheetIntoStruct[settingsT](cfg,
+			withFetch(func(cfg Config) (*sheets.ValueRange, error) {
+				assert.Equal(t, MajorDimensionColumns, cfg.majorDimension)
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"Key", "FeatureX", "price", "currency", "Owner"},
+						{"Value", "true", "9.99", "", "foo"},
+					},
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, settingsT{FeatureX: true, Price: 9.99, Currency: "EUR", Discount: ptrTo(0), Owner: "foo"}, settings)
+	})
+
+	t.Run("headerless", func(t *testing.T) {
+		t.Parallel()
+
+		settings, err := ParseSheetIntoStruct[settingsT](cfg,
+			WithHeaderless(true),
+			withFetch(func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"price", "Discount", "Owner", "currency"},
+						{"1.5", "10", "bar", "USD"},
+					},
+				}, nil
+			}),
+			WithAllowSkipFields(true),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, settingsT{Price: 1.5, Currency: "USD", Discount: ptrTo(10), Owner: "bar"}, settings)
+	})
+
+	t.Run("transposed", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseSheetIntoStruct[settingsT](cfg,
+			WithMajorDimension(MajorDimensionColumns),
+			withFetch(func(cfg Config) (*sheets.ValueRange, error) {
+				assert.Equal(t, MajorDimensionRows, cfg.majorDimension)
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"Key", "FeatureX", "price", "Owner"},
+						{"Value", "true", "free", "foo"},
+					},
+				}, nil
+			}),
+		)
+		var mappingErr *MappingError
+		require.ErrorAs(t, err, &mappingErr)
+		assert.Equal(t, "C2", mappingErr.Cell)
+	})
+
+	t.Run("row options", func(t *testing.T) {
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"currency", "price", "Owner"},
+					{"#ff0000", "1.5", "foo"},
+				},
+			}, nil
+		})
+
+		for name, opt := range map[string]ConfigOption{
+			"comment prefix":    WithCommentPrefix("#"),
+			"row filter":        WithRowFilter(func(int, []string) bool { return false }),
+			"stop at":           WithStopAt(func(int, []string) bool { return true }),
+			"stop at blank row": WithStopAtBlankRow(true),
+			"max rows":          WithMaxRows(1),
+		} {
+			t.Run(name, func(t *testing.T) {
+				t.Parallel()
+
+				settings, err := ParseSheetIntoStruct[settingsT](cfg, WithHeaderless(true), WithAllowSkipFields(true), fetcher, opt)
+				require.NoError(t, err)
+				assert.Equal(t, settingsT{Price: 1.5, Currency: "#ff0000", Discount: ptrTo(0), Owner: "foo"}, settings)
+			})
+		}
+
+		t.Run("blank values", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoStruct[settingsT](cfg, WithHeaderless(true), WithAllowSkipFields(true), WithStopAtBlankRow(true),
+				withFetch(func(Config) (*sheets.ValueRange, error) {
+					return &sheets.ValueRange{Values: [][]any{{"price", "Owner"}}}, nil
+				}),
+			)
+			assert.ErrorIs(t, err, ErrRequiredValue)
+		})
+	})
+
+	t.Run("blank keys", func(t *testing.T) {
+		fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"price", "Owner", "", "currency"},
+					{"1.5", "bar", "", "USD"},
+				},
+			}, nil
+		})
+
+		t.Run("end of the keys", func(t *testing.T) {
+			t.Parallel()
+
+			settings, err := ParseSheetIntoStruct[settingsT](cfg, WithHeaderless(true), WithAllowSkipFields(true), fetcher)
+			require.NoError(t, err)
+			assert.Equal(t, settingsT{Price: 1.5, Currency: "EUR", Discount: ptrTo(0), Owner: "bar"}, settings)
+		})
+
+		t.Run("skipped", func(t *testing.T) {
+			t.Parallel()
+
+			settings, err := ParseSheetIntoStruct[settingsT](cfg, WithHeaderless(true), WithAllowSkipFields(true),
+				WithSkipBlankHeaders(true), fetcher)
+			require.NoError(t, err)
+			assert.Equal(t, settingsT{Price: 1.5, Currency: "USD", Discount: ptrTo(0), Owner: "bar"}, settings)
+		})
+	})
+}
+
 type allTypesTT struct {
 	name  string
 	fetch fetchFN
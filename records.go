@@ -0,0 +1,166 @@
+package gsheets
+
+import (
+	"fmt"
+	"iter"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// Record is a single row of a sheet, which is parsed without a Go struct, e.g. if the schema is only known at runtime.
+// Its cells are accessed by their header caption, as determined by the header matcher, or by their column letter
+// in sheets without a header. The typed getters convert the cells like the fields of a struct.
+type Record struct {
+	// Row holds the 1-based row number of the record, or the column number for sheets laid out in columns.
+	Row int
+
+	cfg    Config
+	header *sheetHeader
+	row    tableRow
+}
+
+// ParseSheetIntoRecords parses a sheet page and returns an iterator over its records.
+// The yielded index is the 1-based row number of the record. See ParseSheetIntoStructs for details.
+// The name of the sheet must be given via WithSheetName, otherwise ErrNoSheetName is returned.
+func ParseSheetIntoRecords(cfg Config, opts ...ConfigOption) (iter.Seq2[int, Record], error) {
+	cfg, err := cfg.init(nil, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cfg.fetch(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.loadMerges(); err != nil {
+		return nil, err
+	}
+
+	captions, data := splitHeader(cfg, resp.Values)
+	header := newSheetHeader(captions, cfg)
+	rows, _ := tableRows(cfg, resp.Values, data)
+	return func(yield func(int, Record) bool) {
+		for rowIdx, row := range rows {
+			if !yield(rowIdx, Record{Row: rowIdx, cfg: cfg, header: header, row: row}) {
+				return
+			}
+		}
+	}, cfg.Context().Err()
+}
+
+// Headers returns the non-blank header captions of the record, in the order of their columns.
+// Captions of grouped headers are joined by " / ", e.g. "Billing / Street".
+func (r Record) Headers() []string {
+	headers := make([]string, 0, len(r.header.captions))
+	for _, caption := range r.header.captions {
+		if caption != "" {
+			headers = append(headers, caption)
+		}
+	}
+	return headers
+}
+
+// Get returns the raw value of the cell with the given header, and whether the header exists.
+func (r Record) Get(header string) (string, bool) {
+	colIdx, ok := r.column(header)
+	if !ok {
+		return "", false
+	}
+	return r.row.cell(colIdx), true
+}
+
+// Map returns the raw values of all cells with a header, keyed by their header caption.
+func (r Record) Map() map[string]string {
+	values := make(map[string]string, len(r.header.captions))
+	for colIdx, caption := range r.header.captions {
+		if caption != "" {
+			values[caption] = r.row.cell(colIdx)
+		}
+	}
+	return values
+}
+
+// CellName returns the A1 notation of the cell with the given header, and whether the header exists.
+func (r Record) CellName(header string) (string, bool) {
+	colIdx, ok := r.column(header)
+	if !ok {
+		return "", false
+	}
+	return r.cfg.cellName(colIdx, r.Row), true
+}
+
+// String returns the value of the cell with the given header.
+func (r Record) String(header string) (string, error) {
+	return recordValue[string](r, header)
+}
+
+// Int converts the cell with the given header into an int. Empty cells result in 0.
+func (r Record) Int(header string) (int, error) {
+	return recordValue[int](r, header)
+}
+
+// Float converts the cell with the given header into a float64. Empty cells result in 0.
+func (r Record) Float(header string) (float64, error) {
+	return recordValue[float64](r, header)
+}
+
+// Bool converts the cell with the given header into a bool. Empty cells result in false.
+func (r Record) Bool(header string) (bool, error) {
+	return recordValue[bool](r, header)
+}
+
+// Time converts the cell with the given header into a time.Time, using the configured date-time formats.
+// Empty cells result in the zero time.
+func (r Record) Time(header string) (time.Time, error) {
+	return recordValue[time.Time](r, header)
+}
+
+// column returns the index of the column with the given header, or column letter in sheets without a header.
+func (r Record) column(header string) (int, bool) {
+	if r.cfg.headerless {
+		colIdx := parseColumn(header)
+		return colIdx, colIdx >= 0 && colIdx < len(r.row.cells)
+	}
+
+	// captions containing the group separator take precedence over grouped headers, so that
+	// all captions returned by Headers and Map can be looked up
+	if idxs, ok := r.header.colNames[r.cfg.headerKey(header)]; ok {
+		return idxs[0], true
+	}
+
+	idxs, ok := r.header.colNames[r.cfg.headerKey(strings.Split(header, groupSeparator)...)]
+	if !ok {
+		return -1, false
+	}
+	return idxs[0], true
+}
+
+// recordValue converts the cell with the given header into the given type, using the converters of struct fields.
+// Conversion errors are returned as *MappingError.
+func recordValue[T any](r Record, header string) (T, error) {
+	var val T
+	colIdx, ok := r.column(header)
+	if !ok {
+		return val, fmt.Errorf("%w: %q", ErrFieldNotFoundInSheet, header)
+	}
+
+	cv := r.row.cell(colIdx)
+	if cv == "" {
+		return val, nil
+	}
+
+	ref, _, err := makeConvertFunc(reflect.TypeFor[T](), false)(cv, r.cfg.datetimeFormats)
+	if err != nil {
+		return val, &MappingError{
+			Sheet:  r.cfg.sheetName,
+			Cell:   r.cfg.cellName(colIdx, r.Row),
+			Field:  header,
+			Header: header,
+			Value:  cv,
+			err:    err,
+		}
+	}
+	return ref.Interface().(T), nil
+}
@@ -0,0 +1,143 @@
+package gsheets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestParseSheetIntoRecords(t *testing.T) {
+	cfg := MakeConfig(_svc, "test-workbook", WithSheetName("imports"))
+	fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+		return &sheets.ValueRange{
+			Values: [][]any{
+				{"SKU", "Price", "Active", "Created At"},
+				{"foo", "1.5", "true", "2026-01-02"},
+				{"bar", "free", "", ""},
+			},
+		}, nil
+	})
+
+	t.Run("error from fetch method", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseSheetIntoRecords(cfg, withFetch(errorFetcher))
+		assert.ErrorIs(t, err, fetcherError)
+	})
+
+	t.Run("records", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := ParseSheetIntoRecords(cfg, fetcher, WithHeaderMatcher(MatchNormalized))
+		require.NoError(t, err)
+
+		var records []Record
+		for rowIdx, record := range results {
+			assert.Equal(t, rowIdx, record.Row)
+			records = append(records, record)
+		}
+		require.Len(t, records, 2)
+
+		foo := records[0]
+		assert.Equal(t, []string{"SKU", "Price", "Active", "Created At"}, foo.Headers())
+		assert.Equal(t, map[string]string{"SKU": "foo", "Price": "1.5", "Active": "true", "Created At": "2026-01-02"}, foo.Map())
+
+		sku, ok := foo.Get("sku")
+		assert.True(t, ok)
+		assert.Equal(t, "foo", sku)
+
+		cell, ok := foo.CellName("created_at")
+		assert.True(t, ok)
+		assert.Equal(t, "D2", cell)
+
+		s, err := foo.String("SKU")
+		require.NoError(t, err)
+		assert.Equal(t, "foo", s)
+
+		f, err := foo.Float("Price")
+		require.NoError(t, err)
+		assert.Equal(t, 1.5, f)
+
+		b, err := foo.Bool("Active")
+		require.NoError(t, err)
+		assert.True(t, b)
+
+		tm, err := foo.Time("CreatedAt")
+		require.NoError(t, err)
+		assert.Equal(t, time.Date(2026, time.January, 2, 0, 0, 0, 0, time.UTC), tm)
+
+		bar := records[1]
+		b, err = bar.Bool("Active")
+		require.NoError(t, err)
+		assert.False(t, b)
+
+		_, err = bar.Int("Price")
+		var mappingErr *MappingError
+		require.ErrorAs(t, err, &mappingErr)
+		assert.Equal(t, "B3", mappingErr.Cell)
+		assert.Equal(t, "free", mappingErr.Value)
+
+		_, ok = bar.Get("Quantity")
+		assert.False(t, ok)
+		_, err = bar.Int("Quantity")
+		assert.ErrorIs(t, err, ErrFieldNotFoundInSheet)
+	})
+
+	t.Run("no sheet name", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseSheetIntoRecords(MakeConfig(_svc, "test-workbook"), fetcher)
+		assert.ErrorIs(t, err, ErrNoSheetName)
+	})
+
+	t.Run("captions with group separator", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := ParseSheetIntoRecords(cfg, withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"SKU", "Size / Color"},
+					{"foo", "M / red"},
+				},
+			}, nil
+		}))
+		require.NoError(t, err)
+
+		for _, record := range results {
+			for _, header := range record.Headers() {
+				_, ok := record.Get(header)
+				assert.True(t, ok, header)
+			}
+			for header, value := range record.Map() {
+				v, ok := record.Get(header)
+				assert.True(t, ok, header)
+				assert.Equal(t, value, v)
+			}
+		}
+	})
+
+	t.Run("headerless", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := ParseSheetIntoRecords(cfg, fetcher, WithHeaderless(true), WithMaxRows(1))
+		require.NoError(t, err)
+
+		for rowIdx, record := range results {
+			assert.Equal(t, 1, rowIdx)
+
+			i, err := record.String("B")
+			require.NoError(t, err)
+			assert.Equal(t, "Price", i)
+
+			cell, ok := record.CellName("2")
+			assert.True(t, ok)
+			assert.Equal(t, "B1", cell)
+
+			_, ok = record.Get("Z")
+			assert.False(t, ok)
+		}
+	})
+}
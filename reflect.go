@@ -1,80 +1,465 @@
 package gsheets
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
 	"time"
 )
 
+const (
+	// patternPrefix marks the column name of a tag as regular expression.
+	patternPrefix = "re:"
+	// aliasSeparator separates multiple possible column names in a tag.
+	aliasSeparator = "|"
+	// escapePrefix escapes a separator in a tag, e.g. `gsheets:"Price \\| Unit"` matches the header "Price | Unit",
+	// and `gsheets:"re:^\\d{1\\,3}$"` keeps the comma in the pattern instead of starting the options.
+	escapePrefix = `\`
+	// tagOptionPrefix prepends a prefix to the column names of all fields of a nested struct, e.g. `gsheets:",prefix=Billing "`.
+	tagOptionPrefix = "prefix"
+	// tagOptionRequired marks a field, for which empty cells are raised as errors, e.g. `gsheets:",required"`.
+	tagOptionRequired = "required"
+	// tagOptionDefault defines the value used for empty cells or missing columns, e.g. `gsheets:",default=42"`.
+	// It's converted once, when the fields are mapped, except for date-time fields.
+	tagOptionDefault = "default"
+	// tagOptionCol pins a field to a column letter or 1-based column index, e.g. `gsheets:",col=C"`.
+	tagOptionCol = "col"
+	// tagOptionRowNum injects the 1-based row number of the record into an int, int64 or uint64 field, e.g. `gsheets:",rownum"`.
+	tagOptionRowNum = "rownum"
+	// tagOptionSheet injects the name of the sheet into a string field, e.g. `gsheets:",sheet"`.
+	tagOptionSheet = "sheet"
+	// tagOptionSpreadsheet injects the ID of the spreadsheet into a string field, e.g. `gsheets:",spreadsheet"`.
+	tagOptionSpreadsheet = "spreadsheet"
+	// tagOptionRaw injects the cells of the record as fetched into a []any, []string or map[string]string field,
+	// e.g. `gsheets:",raw"`.
+	tagOptionRaw = "raw"
+	// tagOptionFillDown fills empty cells with the last non-empty value above them, e.g. `gsheets:",filldown"`.
+	tagOptionFillDown = "filldown"
+	// tagOptionGroupKey marks the field of a parent type, whose column is empty in child rows, e.g. `gsheets:"ID,groupkey"`.
+	tagOptionGroupKey = "groupkey"
+	// tagOptionChildren marks the slice field of a parent type, which receives the child rows, e.g. `gsheets:",children"`.
+	tagOptionChildren = "children"
+	// tagOptionUnpivot marks the field, which receives the header caption of each unpivoted column, e.g.
+	// `gsheets:"re:^\\d{4}-\\d{2}$,unpivot"`. Each matching column is turned into its own record.
+	tagOptionUnpivot = "unpivot"
+	// tagOptionUnpivotValue marks the field, which receives the cell of each unpivoted column, e.g. `gsheets:",unpivotvalue"`.
+	tagOptionUnpivotValue = "unpivotvalue"
+)
+
+// tagOptions holds the options of a tag, following the column name.
+type tagOptions []string
+
+func parseTagOptions(v string) tagOptions {
+	if v == "" {
+		return nil
+	}
+
+	opts := splitEscaped(v, ",")
+	for i, opt := range opts {
+		opts[i] = unescapeTag(opt)
+	}
+	return opts
+}
+
+// splitEscaped splits the given tag value at each separator, which isn't escaped by a preceding escapePrefix.
+// The escapes are kept, so that they can be removed by unescapeTag, or passed on to regular expressions.
+func splitEscaped(v, sep string) []string {
+	var parts []string
+	start := 0
+	for i := 0; i < len(v); i++ {
+		switch {
+		case strings.HasPrefix(v[i:], escapePrefix):
+			i += len(escapePrefix)
+		case strings.HasPrefix(v[i:], sep):
+			parts = append(parts, v[start:i])
+			start = i + len(sep)
+			i = start - 1
+		}
+	}
+	return append(parts, v[start:])
+}
+
+// unescapeTag removes the escapes of the separators from the given tag value.
+func unescapeTag(v string) string {
+	return tagUnescaper.Replace(v)
+}
+
+var tagUnescaper = strings.NewReplacer(escapePrefix+",", ",", escapePrefix+aliasSeparator, aliasSeparator)
+
+// has reports whether the given flag is set.
+func (o tagOptions) has(name string) bool {
+	return slices.Contains(o, name)
+}
+
+// lookup returns the value of the given key=value option.
+func (o tagOptions) lookup(name string) (string, bool) {
+	for _, opt := range o {
+		if k, v, ok := strings.Cut(opt, "="); ok && k == name {
+			return v, true
+		}
+	}
+	return "", false
+}
+
+// metadata returns the kind of metadata to be injected into the field, or an empty string for regular fields.
+func (o tagOptions) metadata() string {
+	for _, opt := range o {
+		if opt == tagOptionRowNum || opt == tagOptionSheet || opt == tagOptionSpreadsheet || opt == tagOptionRaw {
+			return opt
+		}
+	}
+	return ""
+}
+
+// parseColumn parses a column letter or 1-based column index into a 0-based column index.
+// It returns -1 if the column is invalid.
+func parseColumn(col string) int {
+	if i, err := strconv.Atoi(col); err == nil {
+		if i < 1 {
+			return -1
+		}
+		return i - 1
+	}
+	return columnIndex(col)
+}
+
 type convertFunc func(string, []string) (reflect.Value, bool, error)
 type mapping struct {
 	field        reflect.StructField
 	convert      convertFunc
 	initEmbedPtr func(reflect.Value)
 	colIndex     int
+	colIndexes   []int
+	consumed     []int
 	colName      string
+	aliases      []string
+	pattern      *regexp.Regexp
+	prefix       string
+	header       string
+	groups       []string
+	colPos       int
+	isSlice      bool
+	fillDown     bool
+	groupKey     bool
+	children     bool
+	unpivot      bool
+	unpivotValue bool
+	pivotValues  []reflect.Value
+	meta         string
+	rawKeys      []string
+	required     bool
+	hasDefault   bool
 	typeName     string
 	err          error
+	tagErr       error
 }
 
-func createMappings(t reflect.Type, captions []any, opts Config) ([]*mapping, error) {
+func createMappings(t reflect.Type, captions [][]any, opts Config) ([]*mapping, error) {
 	if t.Kind() != reflect.Struct {
 		return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, t.Kind().String())
 	}
 
-	// first we determine the column names and their corresponding fields
-	colNames := make(map[string]int, len(captions))
-	for colIdx, cellIf := range captions {
-		cell := cellIf.(string)
-		if cell == "" {
-			break
+	// we read the tags and create the mappings
+	fields := readTags(opts.tagName, t, nil, nil, "", nil)
+
+	// child rows are only mapped into the parent type of ParseSheetIntoGroups
+	if !opts.allowChildren {
+		for _, m := range fields {
+			if m.children {
+				return nil, fmt.Errorf("%w: field %q: the tag option %q is only supported by the parent type of ParseSheetIntoGroups",
+					ErrInvalidTag, m.field.Name, tagOptionChildren)
+			}
+		}
+	}
+
+	// unpivoted records cannot be combined with the rows of other types
+	if opts.multipleTypes {
+		for _, m := range fields {
+			if m.unpivot || m.unpivotValue {
+				return nil, fmt.Errorf("%w: field %q: the tag options %q and %q are unsupported, if multiple types are mapped",
+					ErrInvalidTag, m.field.Name, tagOptionUnpivot, tagOptionUnpivotValue)
+			}
+		}
+	}
+
+	// slice fields receive duplicate columns positionally, and are unsupported otherwise
+	if opts.duplicateColumns != DuplicateColumnsSlice {
+		for _, m := range fields {
+			if m.isSlice && m.err == nil {
+				m.err = fmt.Errorf("%w: field %q of type %q is unsupported", ErrUnsupportedType, m.field.Name, reflect.Slice.String())
+			}
 		}
-		colNames[cell] = colIdx
 	}
 
-	// then we read the tags and create the mappings
-	fields := readTags(opts.tagName, t, nil, nil)
+	return mapColumns(fields, captions, opts)
+}
+
+// mapColumns sets the column indexes of the given mappings to the matching columns of the header.
+func mapColumns(fields []*mapping, captions [][]any, opts Config) ([]*mapping, error) {
+	// first we determine the column names and their corresponding fields
+	header := newSheetHeader(captions, opts)
 
 	// next we set the column index for each mapping
 	mapped := make([]*mapping, 0, len(fields))
+	var mismatch SchemaMismatchError
+	var pivot, value *mapping
 	for _, m := range fields {
-		if idx, ok := colNames[m.colName]; ok {
-			m.colIndex = idx
+		if m.tagErr != nil {
+			return nil, m.tagErr
+		}
+		if !m.hasColumn() {
+			if m.meta == tagOptionRaw {
+				m.rawKeys = header.captions
+			}
+			mapped = append(mapped, m)
+			continue
+		}
+		if opts.headerRows <= 1 {
+			m.groups = nil
+		}
+		if m.colPos >= 0 {
 			if m.err != nil {
 				return nil, m.err
 			}
+			if m.colPos < len(header.captions) {
+				m.header = header.captions[m.colPos]
+				header.remove(m.colPos)
+			}
+			m.colIndex = m.colPos
+			m.colIndexes = []int{m.colPos}
 			mapped = append(mapped, m)
-			delete(colNames, m.colName)
 			continue
 		}
-		if !opts.allowSkipFields {
-			return nil, fmt.Errorf("%w: %q", ErrFieldNotFoundInSheet, m.colName)
+		if m.unpivotValue {
+			if m.err != nil {
+				return nil, m.err
+			}
+			value = m
+			mapped = append(mapped, m)
+			continue
+		}
+		if m.unpivot {
+			if keys := matchColumns(m, header, opts); len(keys) > 0 {
+				if m.err != nil {
+					return nil, m.err
+				}
+				for _, key := range keys {
+					m.colIndexes = append(m.colIndexes, header.colNames[key]...)
+					delete(header.colNames, key)
+				}
+				slices.Sort(m.colIndexes)
+				if err := m.convertCaptions(header, len(captions), opts); err != nil {
+					return nil, err
+				}
+				pivot = m
+				mapped = append(mapped, m)
+				continue
+			}
+		}
+		key, err := findColumn(m, header, opts)
+		if err != nil {
+			return nil, err
+		}
+		if idxs, ok := header.colNames[key]; ok {
+			if m.err != nil {
+				return nil, m.err
+			}
+			m.header = header.captions[idxs[0]]
+			m.consumed = idxs // including the ignored duplicates
+			if len(idxs) > 1 {
+				switch {
+				case opts.duplicateColumns == DuplicateColumnsFirst:
+					idxs = idxs[:1]
+				case opts.duplicateColumns == DuplicateColumnsLast:
+					idxs = idxs[len(idxs)-1:]
+				case opts.duplicateColumns == DuplicateColumnsSlice && m.isSlice:
+				default:
+					err := &DuplicateColumnError{Sheet: opts.sheetName, Name: m.header, Indexes: idxs}
+					for _, idx := range idxs {
+						err.Columns = append(err.Columns, opts.columnName(idx))
+					}
+					return nil, err
+				}
+			}
+			m.colIndex = idxs[0]
+			m.colIndexes = idxs
+			mapped = append(mapped, m)
+			delete(header.colNames, key)
+			continue
 		}
+		if m.hasDefault && m.err == nil {
+			mapped = append(mapped, m)
+			continue
+		}
+		// unpivoted columns are never skipped, as the rows wouldn't yield any records otherwise
+		if !opts.allowSkipFields || m.unpivot {
+			mismatch.MissingFields = append(mismatch.MissingFields, MissingField{
+				Field: m.typeName + "." + m.field.Name,
+				Name:  strings.Join(append(slices.Clone(m.groups), m.colName), groupSeparator),
+			})
+		}
+	}
+
+	// unpivoted columns are converted into the value field of their records
+	if slices.ContainsFunc(fields, func(m *mapping) bool { return m.unpivot }) != slices.ContainsFunc(fields, func(m *mapping) bool { return m.unpivotValue }) {
+		return nil, fmt.Errorf("%w: the tag options %q and %q must be used together", ErrInvalidTag, tagOptionUnpivot, tagOptionUnpivotValue)
+	}
+	if value != nil && pivot != nil {
+		value.colIndexes = pivot.colIndexes
 	}
 
 	// here we check if there are any columns left, and raise an error if it's not allowed to skip them
-	if len(colNames) > 0 && !opts.allowSkipColumns {
-		errs := make([]error, 0, len(colNames))
-		// todo: sort by column index
-		for colName, idx := range colNames {
-			errs = append(errs, fmt.Errorf("%w: %q in column %q", ErrFieldNotFoundInStruct, colName, columnName(idx)))
+	if len(header.colNames) > 0 && !opts.allowSkipColumns {
+		for _, idxs := range header.colNames {
+			for _, idx := range idxs {
+				mismatch.UnknownColumns = append(mismatch.UnknownColumns, UnknownColumn{
+					Name:   header.captions[idx],
+					Column: opts.columnName(idx),
+					Index:  idx,
+				})
+			}
 		}
-		return nil, errors.Join(errs...)
+		slices.SortFunc(mismatch.UnknownColumns, func(a, b UnknownColumn) int {
+			return a.Index - b.Index
+		})
+	}
+
+	if len(mismatch.MissingFields) > 0 || len(mismatch.UnknownColumns) > 0 {
+		mismatch.Sheet = opts.sheetName
+		return nil, &mismatch
 	}
 
 	// finally we check if there are actually mappings
-	if len(mapped) == 0 {
+	if !slices.ContainsFunc(mapped, (*mapping).hasColumn) {
 		return nil, ErrNoMapping
 	}
 
 	return mapped, nil
 }
 
-func readTags(tagName string, t reflect.Type, index []int, parentInit func(reflect.Value)) []*mapping {
+// mappedColumns returns the indexes of the columns mapped by the given mappings,
+// including the duplicate columns ignored by them.
+func mappedColumns(mappings ...[]*mapping) map[int]bool {
+	mapped := make(map[int]bool)
+	for _, m := range slices.Concat(mappings...) {
+		for _, colIdx := range slices.Concat(m.colIndexes, m.consumed) {
+			mapped[colIdx] = true
+		}
+	}
+	return mapped
+}
+
+// checkUnknownColumns raises a *SchemaMismatchError for the columns of the header, which are not mapped,
+// unless it's allowed to skip them. It's used, if the columns are mapped by multiple types together.
+func checkUnknownColumns(captions [][]any, opts Config, mapped map[int]bool) error {
+	if opts.allowSkipColumns {
+		return nil
+	}
+
+	var mismatch SchemaMismatchError
+	for colIdx, caption := range newSheetHeader(captions, opts).captions {
+		if caption != "" && !mapped[colIdx] {
+			mismatch.UnknownColumns = append(mismatch.UnknownColumns, UnknownColumn{
+				Name:   caption,
+				Column: opts.columnName(colIdx),
+				Index:  colIdx,
+			})
+		}
+	}
+	if len(mismatch.UnknownColumns) > 0 {
+		mismatch.Sheet = opts.sheetName
+		return &mismatch
+	}
+
+	return nil
+}
+
+// convertCaptions converts the header captions of the unpivoted columns into the values of the field.
+// The number of header rows is used to point errors at the cell of the caption.
+func (m *mapping) convertCaptions(header *sheetHeader, headerRows int, opts Config) error {
+	m.pivotValues = make([]reflect.Value, 0, len(m.colIndexes))
+	for _, colIdx := range m.colIndexes {
+		path := header.paths[colIdx]
+		caption := strings.TrimPrefix(path[len(path)-1], m.prefix)
+		val, _, err := m.convert(caption, opts.datetimeFormats)
+		if err != nil {
+			return &MappingError{
+				Sheet:  opts.sheetName,
+				Cell:   opts.cellName(colIdx, headerRows+opts.recordOffset),
+				Field:  m.typeName + "." + m.field.Name,
+				Header: header.captions[colIdx],
+				Value:  caption,
+				err:    err,
+			}
+		}
+		m.pivotValues = append(m.pivotValues, val)
+	}
+	return nil
+}
+
+// hasColumn reports whether the mapping is converted from a column, i.e. it neither holds metadata nor child rows.
+func (m *mapping) hasColumn() bool {
+	return m.meta == "" && !m.children
+}
+
+// findColumn returns the key of the column in colNames matching the given mapping.
+// Aliases are tried in order, and the first one found in the header wins.
+// Patterns are matched against the header captions, and if more than one column matches,
+// an *AmbiguousColumnError is returned.
+func findColumn(m *mapping, header *sheetHeader, opts Config) (string, error) {
+	keys := matchColumns(m, header, opts)
+	if len(keys) > 1 && m.pattern != nil {
+		err := &AmbiguousColumnError{Sheet: opts.sheetName, Field: m.typeName + "." + m.field.Name}
+		for _, key := range keys {
+			idx := header.colNames[key][0]
+			err.Headers = append(err.Headers, header.captions[idx])
+			err.Columns = append(err.Columns, opts.columnName(idx))
+			err.Indexes = append(err.Indexes, idx)
+		}
+		return "", err
+	}
+
+	if len(keys) == 0 {
+		return "", nil
+	}
+
+	return keys[0], nil
+}
+
+// matchColumns returns the keys of all columns in colNames matching the given mapping.
+func matchColumns(m *mapping, header *sheetHeader, opts Config) []string {
+	var keys []string
+	if m.pattern != nil {
+		groupKey := opts.headerKey(m.groups...)
+		for colIdx, path := range header.paths {
+			if len(path) != len(m.groups)+1 || opts.headerKey(path[:len(m.groups)]...) != groupKey {
+				continue
+			}
+			caption, ok := strings.CutPrefix(path[len(m.groups)], m.prefix)
+			if !ok {
+				continue
+			}
+			key := opts.headerKey(path...)
+			if idxs, ok := header.colNames[key]; ok && idxs[0] == colIdx && m.pattern.MatchString(caption) {
+				keys = append(keys, key)
+			}
+		}
+	} else {
+		for _, alias := range m.aliases {
+			key := opts.headerKey(append(slices.Clone(m.groups), alias)...)
+			if _, ok := header.colNames[key]; ok && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+
+	return keys
+}
+
+func readTags(tagName string, t reflect.Type, index []int, groups []string, prefix string, parentInit func(reflect.Value)) []*mapping {
 	out := make([]*mapping, 0, t.NumField())
 	typeName := t.PkgPath()
 	if typeName != "" {
@@ -93,33 +478,49 @@ func readTags(tagName string, t reflect.Type, index []int, parentInit func(refle
 			field:        f,
 			colName:      f.Name,
 			colIndex:     -1,
+			colPos:       -1,
 			typeName:     typeName,
+			groups:       groups,
 			initEmbedPtr: parentInit,
 		}
 
+		var opts tagOptions
 		if v, ok := f.Tag.Lookup(tagName); ok {
-			name := v
-			if s := strings.Split(v, ","); len(s) > 1 {
-				name = s[0]
+			name, rest := v, ""
+			if parts := splitEscaped(v, ","); len(parts) > 1 {
+				name, rest = parts[0], v[len(parts[0])+1:]
 			}
-
 			if name == "-" {
 				continue
 			}
 
-			m.colName = name
+			if name != "" {
+				m.colName = name
+			}
+			opts = parseTagOptions(rest)
 		}
 
-		switch field, isPointer := indirect(f.Type); field.Kind() {
-		case reflect.Struct:
-			if field == timeType {
-				if isPointer {
-					m.convert = convertTimeP
-					break
-				}
-				m.convert = convertTime
-				break
+		if col, ok := opts.lookup(tagOptionCol); ok {
+			if m.colPos = parseColumn(col); m.colPos < 0 {
+				m.tagErr = fmt.Errorf("%w: field %q: invalid column %q", ErrInvalidTag, f.Name, col)
 			}
+		}
+
+		if m.children = opts.has(tagOptionChildren); m.children {
+			out = append(out, m)
+			continue
+		}
+
+		if m.meta = opts.metadata(); m.meta != "" {
+			if !isMetadataType(m.meta, f.Type) {
+				m.tagErr = fmt.Errorf("%w: field %q of type %q cannot hold the %s", ErrInvalidTag, f.Name, f.Type, m.meta)
+			}
+			out = append(out, m)
+			continue
+		}
+
+		field, isPointer := indirect(f.Type)
+		if field.Kind() == reflect.Struct && field != timeType {
 			initEmbedPtr := parentInit
 			if isPointer {
 				initEmbedPtr = func(ref reflect.Value) {
@@ -133,103 +534,217 @@ func readTags(tagName string, t reflect.Type, index []int, parentInit func(refle
 				}
 			}
 
-			out = append(out, readTags(tagName, field, f.Index, initEmbedPtr)...)
-			continue
-		case reflect.String:
-			if isPointer {
-				m.convert = convertStringP
-				break
-			}
-			m.convert = convertString
-		case reflect.Int:
-			if isPointer {
-				m.convert = convertIntP
-				break
-			}
-			m.convert = convertInt
-		case reflect.Int8:
-			if isPointer {
-				m.convert = makeConvertIntxP[int8](8, reflect.Int8)
-				break
-			}
-			m.convert = makeConvertIntx[int8](8, reflect.Int8)
-		case reflect.Int16:
-			if isPointer {
-				m.convert = makeConvertIntxP[int16](16, reflect.Int16)
-				break
-			}
-			m.convert = makeConvertIntx[int16](16, reflect.Int16)
-		case reflect.Int32:
-			if isPointer {
-				m.convert = makeConvertIntxP[int32](32, reflect.Int32)
-				break
-			}
-			m.convert = makeConvertIntx[int32](32, reflect.Int32)
-		case reflect.Int64:
-			if isPointer {
-				m.convert = makeConvertIntxP[int64](64, reflect.Int64)
-				break
-			}
-			m.convert = makeConvertIntx[int64](64, reflect.Int64)
-		case reflect.Uint:
-			if isPointer {
-				m.convert = makeConvertUintP[uint](0, reflect.Uint)
-				break
-			}
-			m.convert = makeConvertUint[uint](0, reflect.Uint)
-		case reflect.Uint8:
-			if isPointer {
-				m.convert = makeConvertUintP[uint8](8, reflect.Uint8)
-				break
-			}
-			m.convert = makeConvertUint[uint8](8, reflect.Uint8)
-		case reflect.Uint16:
-			if isPointer {
-				m.convert = makeConvertUintP[uint16](16, reflect.Uint16)
-				break
+			// named nested structs form a group of columns in sheets with multiple header rows
+			nestedGroups := groups
+			if !f.Anonymous {
+				nestedGroups = append(slices.Clone(groups), unescapeTag(m.colName))
 			}
-			m.convert = makeConvertUint[uint16](16, reflect.Uint16)
-		case reflect.Uint32:
-			if isPointer {
-				m.convert = makeConvertUintP[uint32](32, reflect.Uint32)
-				break
-			}
-			m.convert = makeConvertUint[uint32](32, reflect.Uint32)
-		case reflect.Uint64:
-			if isPointer {
-				m.convert = makeConvertUintP[uint64](64, reflect.Uint64)
-				break
-			}
-			m.convert = makeConvertUint[uint64](64, reflect.Uint64)
-		case reflect.Float32:
-			if isPointer {
-				m.convert = makeConvertFloatP[float32](32, reflect.Float32)
-				break
+			nestedPrefix, _ := opts.lookup(tagOptionPrefix)
+			out = append(out, readTags(tagName, field, f.Index, nestedGroups, prefix+nestedPrefix, initEmbedPtr)...)
+			continue
+		}
+
+		if pattern, ok := strings.CutPrefix(m.colName, patternPrefix); ok {
+			// escaped pipes are kept, as they match a literal pipe in the pattern anyway
+			re, err := regexp.Compile(strings.ReplaceAll(pattern, escapePrefix+",", ","))
+			if err != nil {
+				m.tagErr = fmt.Errorf("%w: field %q: %w", ErrInvalidColumnPattern, f.Name, err)
 			}
-			m.convert = makeConvertFloat[float32](32, reflect.Float32)
-		case reflect.Float64:
-			if isPointer {
-				m.convert = makeConvertFloatP[float64](64, reflect.Float64)
-				break
+			m.pattern = re
+			m.prefix = prefix
+		} else {
+			m.aliases = splitEscaped(m.colName, aliasSeparator)
+			for i, alias := range m.aliases {
+				m.aliases[i] = unescapeTag(alias)
 			}
-			m.convert = makeConvertFloat[float64](64, reflect.Float64)
-		case reflect.Bool:
-			if isPointer {
-				m.convert = convertBoolP
-				break
+			if prefix != "" {
+				for i, alias := range m.aliases {
+					m.aliases[i] = prefix + alias
+				}
+				m.colName = strings.Join(m.aliases, aliasSeparator)
 			}
-			m.convert = convertBool
-		default:
+		}
+
+		typ := f.Type
+		if field.Kind() == reflect.Slice && !isPointer {
+			m.isSlice = true
+			typ = field.Elem()
+			field, isPointer = indirect(typ)
+		}
+
+		if m.convert = makeConvertFunc(field, isPointer); m.convert == nil {
 			m.err = fmt.Errorf("%w: field %q of type %q is unsupported", ErrUnsupportedType, f.Name, field.Kind().String())
+			out = append(out, m)
+			continue
 		}
 
-		m.convert = wrapEmpty(f.Type, m.convert)
+		m.fillDown = opts.has(tagOptionFillDown)
+		m.groupKey = opts.has(tagOptionGroupKey)
+		m.unpivot = opts.has(tagOptionUnpivot)
+		m.unpivotValue = opts.has(tagOptionUnpivotValue)
+		m.required = opts.has(tagOptionRequired)
+		def, hasDefault := opts.lookup(tagOptionDefault)
+		m.hasDefault = hasDefault
+		if hasDefault && field != timeType {
+			// the date-time formats are only known when parsing, so defaults of time fields are converted by then
+			defVal, _, err := m.convert(def, nil)
+			if err != nil {
+				m.tagErr = fmt.Errorf("%w: field %q: invalid default value %q: %w", ErrInvalidTag, f.Name, def, err)
+			}
+			m.convert = wrapDefault(m.convert, defVal)
+		} else {
+			m.convert = wrapEmpty(typ, m.convert, def, hasDefault, m.required)
+		}
 		out = append(out, m)
 	}
 
 	return out
 }
 
+// isMetadataType reports whether the given type can hold the given kind of metadata.
+func isMetadataType(meta string, t reflect.Type) bool {
+	switch meta {
+	case tagOptionRowNum:
+		// smaller integers would overflow on large sheets
+		return slices.Contains([]reflect.Kind{reflect.Int, reflect.Int64, reflect.Uint64}, t.Kind())
+	case tagOptionRaw:
+		return t == reflect.TypeFor[[]any]() || t == reflect.TypeFor[[]string]() || t == reflect.TypeFor[map[string]string]()
+	default:
+		return t.Kind() == reflect.String
+	}
+}
+
+// setMetadata injects the metadata of the record with the given index and cells into the field of the mapping.
+// The raw cells are injected as fetched, i.e. without the empty cells padding the row to the width of the table.
+// In maps, they are keyed by their header caption, or by their column name in sheets without a header.
+func (m *mapping) setMetadata(ref reflect.Value, row []any, rowIdx int, cfg Config) {
+	if m.initEmbedPtr != nil {
+		m.initEmbedPtr(ref)
+	}
+
+	field := ref.FieldByIndex(m.field.Index)
+	switch m.meta {
+	case tagOptionRowNum:
+		if field.Kind() == reflect.Uint64 {
+			field.SetUint(uint64(rowIdx))
+			return
+		}
+		field.SetInt(int64(rowIdx))
+	case tagOptionSheet:
+		field.SetString(cfg.sheetName)
+	case tagOptionSpreadsheet:
+		field.SetString(cfg.spreadsheetID)
+	case tagOptionRaw:
+		if field.Type() == reflect.TypeFor[[]any]() {
+			field.Set(reflect.ValueOf(slices.Clone(row)))
+			return
+		}
+
+		cells := rowCells(row)
+		if field.Kind() == reflect.Slice {
+			field.Set(reflect.ValueOf(cells))
+			return
+		}
+
+		raw := make(map[string]string, len(cells))
+		for idx, cell := range cells {
+			switch {
+			case cfg.headerless:
+				raw[cfg.columnName(idx)] = cell
+			case idx < len(m.rawKeys) && m.rawKeys[idx] != "":
+				raw[m.rawKeys[idx]] = cell
+			}
+		}
+		field.Set(reflect.ValueOf(raw))
+	}
+}
+
+// makeConvertFunc returns the convertFunc for the given type, or nil if the type is unsupported.
+func makeConvertFunc(t reflect.Type, isPointer bool) convertFunc {
+	switch t.Kind() {
+	case reflect.Struct:
+		if t != timeType {
+			return nil
+		}
+		if isPointer {
+			return convertTimeP
+		}
+		return convertTime
+	case reflect.String:
+		if isPointer {
+			return convertStringP
+		}
+		return convertString
+	case reflect.Int:
+		if isPointer {
+			return convertIntP
+		}
+		return convertInt
+	case reflect.Int8:
+		if isPointer {
+			return makeConvertIntxP[int8](8, reflect.Int8)
+		}
+		return makeConvertIntx[int8](8, reflect.Int8)
+	case reflect.Int16:
+		if isPointer {
+			return makeConvertIntxP[int16](16, reflect.Int16)
+		}
+		return makeConvertIntx[int16](16, reflect.Int16)
+	case reflect.Int32:
+		if isPointer {
+			return makeConvertIntxP[int32](32, reflect.Int32)
+		}
+		return makeConvertIntx[int32](32, reflect.Int32)
+	case reflect.Int64:
+		if isPointer {
+			return makeConvertIntxP[int64](64, reflect.Int64)
+		}
+		return makeConvertIntx[int64](64, reflect.Int64)
+	case reflect.Uint:
+		if isPointer {
+			return makeConvertUintP[uint](0, reflect.Uint)
+		}
+		return makeConvertUint[uint](0, reflect.Uint)
+	case reflect.Uint8:
+		if isPointer {
+			return makeConvertUintP[uint8](8, reflect.Uint8)
+		}
+		return makeConvertUint[uint8](8, reflect.Uint8)
+	case reflect.Uint16:
+		if isPointer {
+			return makeConvertUintP[uint16](16, reflect.Uint16)
+		}
+		return makeConvertUint[uint16](16, reflect.Uint16)
+	case reflect.Uint32:
+		if isPointer {
+			return makeConvertUintP[uint32](32, reflect.Uint32)
+		}
+		return makeConvertUint[uint32](32, reflect.Uint32)
+	case reflect.Uint64:
+		if isPointer {
+			return makeConvertUintP[uint64](64, reflect.Uint64)
+		}
+		return makeConvertUint[uint64](64, reflect.Uint64)
+	case reflect.Float32:
+		if isPointer {
+			return makeConvertFloatP[float32](32, reflect.Float32)
+		}
+		return makeConvertFloat[float32](32, reflect.Float32)
+	case reflect.Float64:
+		if isPointer {
+			return makeConvertFloatP[float64](64, reflect.Float64)
+		}
+		return makeConvertFloat[float64](64, reflect.Float64)
+	case reflect.Bool:
+		if isPointer {
+			return convertBoolP
+		}
+		return convertBool
+	default:
+		return nil
+	}
+}
+
 func indirect(t reflect.Type) (reflect.Type, bool) {
 	isPointer := false
 	if t.Kind() == reflect.Ptr {
@@ -243,16 +758,39 @@ var timeType = reflect.TypeFor[time.Time]()
 
 var errVal reflect.Value
 
-func wrapEmpty(p reflect.Type, f convertFunc) convertFunc {
+func wrapEmpty(p reflect.Type, f convertFunc, def string, hasDefault, required bool) convertFunc {
 	zeroVal := reflect.Zero(p)
 	return func(cv string, dateTimeValues []string) (reflect.Value, bool, error) {
 		if cv == "" {
-			return zeroVal, false, nil
+			switch {
+			case hasDefault:
+				cv = def
+			case required:
+				return errVal, false, ErrRequiredValue
+			default:
+				return zeroVal, false, nil
+			}
 		}
 		return f(cv, dateTimeValues)
 	}
 }
 
+// wrapDefault returns a convertFunc, which returns the given converted default value for empty cells.
+func wrapDefault(f convertFunc, def reflect.Value) convertFunc {
+	return func(cv string, dateTimeFormats []string) (reflect.Value, bool, error) {
+		if cv != "" {
+			return f(cv, dateTimeFormats)
+		}
+		if def.Kind() != reflect.Pointer {
+			return def, true, nil
+		}
+		// each row receives its own copy of the default value
+		val := reflect.New(def.Type().Elem())
+		val.Elem().Set(def.Elem())
+		return val, true, nil
+	}
+}
+
 func convertString(cv string, _ []string) (reflect.Value, bool, error) {
 	return reflect.ValueOf(cv), true, nil
 }
@@ -0,0 +1,145 @@
+package gsheets
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+// ErrorKind classifies the failures listed in a Report.
+type ErrorKind string
+
+const (
+	// ErrorKindConversion marks a value that could not be converted into the Go type of the field.
+	ErrorKindConversion ErrorKind = "conversion"
+	// ErrorKindDateTime marks a value that does not match any of the recognized date-time formats.
+	ErrorKindDateTime ErrorKind = "datetime"
+	// ErrorKindRequired marks an empty cell of a required field.
+	ErrorKindRequired ErrorKind = "required"
+	// ErrorKindOther marks any other failure.
+	ErrorKindOther ErrorKind = "other"
+)
+
+// Report is the result of a full-sheet validation.
+// It can be printed as a table via WriteTable, or serialized to JSON.
+type Report struct {
+	Sheet  string        `json:"sheet"`
+	Rows   int           `json:"rows"`
+	Issues []ReportIssue `json:"issues"`
+}
+
+// ReportIssue describes a single cell that could not be mapped.
+type ReportIssue struct {
+	Row     int       `json:"row"`
+	Cell    string    `json:"cell"`
+	Field   string    `json:"field"`
+	Header  string    `json:"header"`
+	Value   string    `json:"value"`
+	Kind    ErrorKind `json:"kind"`
+	Message string    `json:"message"`
+}
+
+// Valid reports whether no issues were found.
+func (r *Report) Valid() bool {
+	return len(r.Issues) == 0
+}
+
+// WriteTable writes the issues of the report as a human-readable table to the given writer.
+func (r *Report) WriteTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if _, err := fmt.Fprintln(tw, "ROW\tCELL\tFIELD\tHEADER\tVALUE\tKIND\tMESSAGE"); err != nil {
+		return err
+	}
+	for _, issue := range r.Issues {
+		if _, err := fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%q\t%s\t%s\n",
+			issue.Row, issue.Cell, issue.Field, issue.Header, issue.Value, issue.Kind, issue.Message); err != nil {
+			return err
+		}
+	}
+	return tw.Flush()
+}
+
+// String returns the table representation of the report.
+func (r *Report) String() string {
+	var sb strings.Builder
+	_ = r.WriteTable(&sb)
+	return sb.String()
+}
+
+// add records the given row error in the report.
+func (r *Report) add(rowIdx int, err error) {
+	var rowErr *RowError
+	if errors.As(err, &rowErr) {
+		for _, err := range rowErr.Unwrap() {
+			r.add(rowIdx, err)
+		}
+		return
+	}
+
+	issue := ReportIssue{Row: rowIdx, Kind: errorKindOf(err), Message: err.Error()}
+	var mappingErr *MappingError
+	if errors.As(err, &mappingErr) {
+		issue.Cell = mappingErr.Cell
+		issue.Field = mappingErr.Field
+		issue.Header = mappingErr.Header
+		issue.Value = mappingErr.Value
+		issue.Message = mappingErr.Unwrap().Error()
+	}
+	r.Issues = append(r.Issues, issue)
+}
+
+func errorKindOf(err error) ErrorKind {
+	var convertErr *ConvertError
+	var dateErr *InvalidDateTimeFormatError
+	switch {
+	case errors.As(err, &convertErr):
+		return ErrorKindConversion
+	case errors.As(err, &dateErr):
+		return ErrorKindDateTime
+	case errors.Is(err, ErrRequiredValue):
+		return ErrorKindRequired
+	default:
+		return ErrorKindOther
+	}
+}
+
+// ParseSheetWithReport parses a whole sheet page, without stopping at erroneous rows.
+// It returns the successfully converted rows together with a Report listing every cell that failed.
+// Errors during validation or when fetching data are returned immediately, as with ParseSheetIntoStructSlice.
+// If an error budget is defined and exceeded, the report collected so far is returned along with an *ErrorBudgetError.
+func ParseSheetWithReport[T any](cfg Config, opts ...ConfigOption) ([]T, *Report, error) {
+	opts = append(slices.Clone(opts), WithCollectRowErrors(true))
+	results, cfg, rows, err := parseSheet[T](cfg, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var errs []error
+	report := &Report{Sheet: cfg.sheetName, Issues: []ReportIssue{}}
+	items := make([]T, 0, rows)
+	for rowIdx, item := range results {
+		report.Rows++
+		if item.Err != nil {
+			report.add(rowIdx, item.Err)
+			if errs = append(errs, item.Err); cfg.errorBudget {
+				if err := cfg.checkErrorBudget(errs, report.Rows, false); err != nil {
+					return nil, report, err
+				}
+			}
+			continue
+		}
+		items = append(items, item.Val)
+	}
+
+	// skipped rows are listed in the report already
+	if cfg.errorBudget {
+		if err := cfg.checkErrorBudget(errs, report.Rows, true); errors.Is(err, ErrErrorBudgetExceeded) {
+			return nil, report, err
+		}
+	}
+
+	return items, report, cfg.Context().Err()
+}
@@ -0,0 +1,134 @@
+package gsheets
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestParseSheetWithReport(t *testing.T) {
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := ParseSheetWithReport[allT](
+			Config{Service: _svc},
+			WithSpreadsheetID("foobar"),
+			withFetch(errorFetcher),
+		)
+		assert.ErrorIs(t, err, fetcherError)
+	})
+
+	type reportT struct {
+		ID      int
+		Created timesT
+	}
+
+	typeName := getTypeName[reportT]()
+	cfg := MakeConfig(_svc, "test-workbook", WithSheetName("test-sheet"), WithTagName("sheets"),
+		withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: [][]any{
+					{"ID", "timesT_value", "timesT_ptr"},
+					{"1", "2024-12-19"},
+					{"two", "yesterday", "2024-12-19"},
+					{"3", "", "today"},
+				},
+			}, nil
+		}),
+	)
+
+	items, report, err := ParseSheetWithReport[reportT](cfg)
+	require.NoError(t, err)
+	require.NotNil(t, report)
+
+	assert.Len(t, items, 1)
+	assert.Equal(t, 1, items[0].ID)
+	assert.False(t, report.Valid())
+	assert.Equal(t, "test-sheet", report.Sheet)
+	assert.Equal(t, 3, report.Rows)
+	assert.Equal(t, []ReportIssue{
+		{
+			Row:     3,
+			Cell:    "A3",
+			Field:   typeName + ".ID",
+			Header:  "ID",
+			Value:   "two",
+			Kind:    ErrorKindConversion,
+			Message: `gsheets: conversion error, could not convert value "two" into Go type "int"`,
+		},
+		{
+			Row:     3,
+			Cell:    "B3",
+			Field:   getTypeName[timesT]() + ".Value",
+			Header:  "timesT_value",
+			Value:   "yesterday",
+			Kind:    ErrorKindDateTime,
+			Message: (&InvalidDateTimeFormatError{CV: "yesterday", Formats: dateTimeFormats[:]}).Error(),
+		},
+		{
+			Row:     4,
+			Cell:    "C4",
+			Field:   getTypeName[timesT]() + ".Ptr",
+			Header:  "timesT_ptr",
+			Value:   "today",
+			Kind:    ErrorKindDateTime,
+			Message: (&InvalidDateTimeFormatError{CV: "today", Formats: dateTimeFormats[:]}).Error(),
+		},
+	}, report.Issues)
+
+	t.Run("options of the caller", func(t *testing.T) {
+		t.Parallel()
+
+		opts := make([]ConfigOption, 1, 2)
+		opts[0] = WithSheetName("test-sheet")
+		_, _, err := ParseSheetWithReport[reportT](cfg, opts...)
+		require.NoError(t, err)
+		assert.Nil(t, opts[:2][1])
+	})
+
+	t.Run("table", func(t *testing.T) {
+		t.Parallel()
+
+		report := &Report{Issues: []ReportIssue{
+			{Row: 3, Cell: "A3", Field: "T.ID", Header: "ID", Value: "two", Kind: ErrorKindConversion, Message: "invalid"},
+			{Row: 12, Cell: "B12", Field: "T.Created", Header: "Created At", Value: "", Kind: ErrorKindOther, Message: "missing"},
+		}}
+
+		const table = `ROW  CELL  FIELD      HEADER      VALUE  KIND        MESSAGE
+3    A3    T.ID       ID          "two"  conversion  invalid
+12   B12   T.Created  Created At  ""     other       missing
+`
+		assert.Equal(t, table, report.String())
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		data, err := json.Marshal(&Report{Sheet: "test-sheet", Rows: 3, Issues: report.Issues[:1]})
+		require.NoError(t, err)
+		assert.JSONEq(t, `{
+			"sheet": "test-sheet",
+			"rows": 3,
+			"issues": [{
+				"row": 3,
+				"cell": "A3",
+				"field": "`+typeName+`.ID",
+				"header": "ID",
+				"value": "two",
+				"kind": "conversion",
+				"message": "gsheets: conversion error, could not convert value \"two\" into Go type \"int\""
+			}]
+		}`, string(data))
+
+		_, valid, err := ParseSheetWithReport[reportT](cfg, withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{Values: [][]any{{"ID", "timesT_value", "timesT_ptr"}, {"1"}}}, nil
+		}))
+		require.NoError(t, err)
+		data, err = json.Marshal(valid)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"sheet": "test-sheet", "rows": 1, "issues": []}`, string(data))
+	})
+}
@@ -0,0 +1,375 @@
+package gsheets
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"iter"
+	"os"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Schema defines the columns of a sheet at runtime, e.g. from a config file, as an alternative to the tags of a struct.
+// Sheets are parsed with a schema via ParseSheetIntoMaps or ParseSheetIntoMapSlice.
+type Schema struct {
+	// Name is used to refer to the columns in errors, e.g. "orders.SKU", and the sheet name is derived from it,
+	// like from the name of a struct, if none is given via WithSheetName. It defaults to "Schema".
+	Name    string   `json:"name,omitempty" yaml:"name,omitempty"`
+	Columns []Column `json:"columns" yaml:"columns"`
+}
+
+// Column defines a single column of a Schema, with the same semantics as the tag of a struct field.
+type Column struct {
+	// Name holds the header caption of the column, which is matched by the header matcher of the Config.
+	// Alternative captions are separated by "|", e.g. "SKU|Article Number". The first caption is the key of the
+	// column's values in the parsed rows. Literal pipes are escaped with a backslash, e.g. "In\\|Out".
+	Name string `json:"name" yaml:"name"`
+	// Type holds the Go type of the column's values, e.g. "int", "float64", "bool" or "time". It defaults to "string".
+	Type string `json:"type,omitempty" yaml:"type,omitempty"`
+	// Col pins the column to a column letter or 1-based column index, e.g. "C", see the `col` tag option.
+	Col string `json:"col,omitempty" yaml:"col,omitempty"`
+	// Required raises empty cells as errors, see the `required` tag option.
+	Required bool `json:"required,omitempty" yaml:"required,omitempty"`
+	// Default holds the value used for empty cells or a missing column, see the `default` tag option.
+	// It is converted and validated once, when the schema is compiled.
+	Default string `json:"default,omitempty" yaml:"default,omitempty"`
+	// Layout holds the only date-time format recognized for a "time" column, e.g. "02.01.2006".
+	Layout string `json:"layout,omitempty" yaml:"layout,omitempty"`
+	// Validate holds the rules, which each non-empty value must satisfy:
+	//   - "min=<n>" and "max=<n>" limit numbers, or the length of strings
+	//   - "oneof=<a>|<b>|..." limits the value to the given values, with literal pipes escaped by a backslash
+	//   - "pattern=<regexp>" requires strings to match the regular expression
+	Validate []string `json:"validate,omitempty" yaml:"validate,omitempty"`
+	// Validators are run after the rules, for validations which cannot be expressed as a rule.
+	Validators []Validator `json:"-" yaml:"-"`
+}
+
+// Validator checks the converted value of a non-empty cell. Errors are wrapped in ErrInvalidValue.
+type Validator func(value any) error
+
+// schemaTypes holds the supported types of Schema columns by their name.
+var schemaTypes = map[string]reflect.Type{
+	"string":  reflect.TypeFor[string](),
+	"int":     reflect.TypeFor[int](),
+	"int8":    reflect.TypeFor[int8](),
+	"int16":   reflect.TypeFor[int16](),
+	"int32":   reflect.TypeFor[int32](),
+	"int64":   reflect.TypeFor[int64](),
+	"uint":    reflect.TypeFor[uint](),
+	"uint8":   reflect.TypeFor[uint8](),
+	"uint16":  reflect.TypeFor[uint16](),
+	"uint32":  reflect.TypeFor[uint32](),
+	"uint64":  reflect.TypeFor[uint64](),
+	"float32": reflect.TypeFor[float32](),
+	"float64": reflect.TypeFor[float64](),
+	"bool":    reflect.TypeFor[bool](),
+	"time":    timeType,
+}
+
+// ParseSchema parses a Schema from the given YAML or JSON document, e.g.
+//
+//	name: orders
+//	columns:
+//	  - name: SKU
+//	    required: true
+//	    validate: ["pattern=^[A-Z0-9-]+$"]
+//	  - name: Ordered At
+//	    type: time
+//	    layout: "02.01.2006"
+func ParseSchema(data []byte) (Schema, error) {
+	var schema Schema
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&schema); err != nil {
+		return Schema{}, fmt.Errorf("%w: %w", ErrInvalidSchema, err)
+	}
+
+	// the date-time formats are only known when parsing, so the defaults of time columns without a layout
+	// are validated by then
+	if _, _, _, err := schema.compile(Config{config: config{headerMatcher: MatchExact}}); err != nil {
+		return Schema{}, err
+	}
+	return schema, nil
+}
+
+// LoadSchema reads a Schema from the YAML or JSON file at the given path. See ParseSchema for details.
+func LoadSchema(path string) (Schema, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Schema{}, err
+	}
+	return ParseSchema(data)
+}
+
+// ParseSheetIntoMaps parses a sheet page with the given schema, and returns an iterator over the parsing Result.
+// Each row is converted into a map holding the values of its non-empty cells, keyed by the name of their column.
+// See ParseSheetIntoStructs for details.
+func ParseSheetIntoMaps(cfg Config, schema Schema, opts ...ConfigOption) (iter.Seq2[int, Result[map[string]any]], error) {
+	results, _, _, err := parseMaps(cfg, schema, opts)
+	return results, err
+}
+
+// ParseSheetIntoMapSlice parses a sheet page with the given schema, and returns a slice of maps.
+// See ParseSheetIntoMaps and ParseSheetIntoStructSlice for details.
+func ParseSheetIntoMapSlice(cfg Config, schema Schema, opts ...ConfigOption) ([]map[string]any, error) {
+	results, cfg, rows, err := parseMaps(cfg, schema, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectResults(cfg, results, rows)
+}
+
+func parseMaps(cfg Config, schema Schema, opts []ConfigOption) (iter.Seq2[int, Result[map[string]any]], Config, int, error) {
+	// the sheet is named after the schema, like after the type of a struct
+	if cfg.sheetName == "" && schema.Name != "" {
+		cfg.sheetName = pluralizeClient.Plural(schema.Name)
+	}
+	cfg, err := cfg.init(nil, opts)
+	if err != nil {
+		return nil, cfg, 0, err
+	}
+
+	t, keys, mappings, err := schema.compile(cfg)
+	if err != nil {
+		return nil, cfg, 0, err
+	}
+
+	resp, err := cfg.fetch(cfg)
+	if err != nil {
+		return nil, cfg, 0, err
+	}
+
+	if err := cfg.loadMerges(); err != nil {
+		return nil, cfg, 0, err
+	}
+
+	captions, data := splitHeader(cfg, resp.Values)
+	if mappings, err = mapColumns(mappings, captions, cfg); err != nil {
+		return nil, cfg, 0, err
+	}
+
+	results, n := convertRows(t, cfg, resp.Values, data, mappings)
+	return func(yield func(int, Result[map[string]any]) bool) {
+		for rowIdx, item := range results {
+			if item.Err != nil {
+				if !yield(rowIdx, Result[map[string]any]{Err: item.Err}) {
+					return
+				}
+				continue
+			}
+
+			values := make(map[string]any, len(keys))
+			for i, key := range keys {
+				if field := item.Val.Field(i); !field.IsNil() {
+					values[key] = field.Elem().Interface()
+				}
+			}
+			if !yield(rowIdx, Result[map[string]any]{Val: values}) {
+				return
+			}
+		}
+	}, cfg, n, cfg.Context().Err()
+}
+
+// compile creates the mappings of the schema's columns, along with the struct type holding the converted values,
+// and the keys of the columns. The struct has a pointer field per column, which stays nil for empty cells.
+// Columns are considered duplicates, if their keys match with the header matcher of the given Config.
+func (s Schema) compile(cfg Config) (reflect.Type, []string, []*mapping, error) {
+	if len(s.Columns) == 0 {
+		return nil, nil, nil, fmt.Errorf("%w: no columns defined", ErrInvalidSchema)
+	}
+
+	typeName := s.Name
+	if typeName == "" {
+		typeName = "Schema"
+	}
+
+	fields := make([]reflect.StructField, 0, len(s.Columns))
+	keys := make([]string, 0, len(s.Columns))
+	headerKeys := make(map[string]bool, len(s.Columns))
+	mappings := make([]*mapping, 0, len(s.Columns))
+	for i, col := range s.Columns {
+		aliases := splitEscaped(col.Name, aliasSeparator)
+		for j, alias := range aliases {
+			aliases[j] = unescapeTag(alias)
+		}
+		key := aliases[0]
+		if key == "" {
+			return nil, nil, nil, fmt.Errorf("%w: column %d has no name", ErrInvalidSchema, i+1)
+		}
+		if headerKeys[cfg.headerKey(key)] {
+			return nil, nil, nil, fmt.Errorf("%w: column %q is defined twice", ErrInvalidSchema, key)
+		}
+		headerKeys[cfg.headerKey(key)] = true
+
+		typ, convert, err := col.converter(cfg.datetimeFormats)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		m := &mapping{
+			field:      reflect.StructField{Name: key, Type: reflect.PointerTo(typ), Index: []int{i}},
+			colName:    col.Name,
+			aliases:    aliases,
+			colIndex:   -1,
+			colPos:     -1,
+			required:   col.Required,
+			hasDefault: col.Default != "",
+			typeName:   typeName,
+			convert:    convert,
+		}
+		if col.Col != "" {
+			if m.colPos = parseColumn(col.Col); m.colPos < 0 {
+				return nil, nil, nil, fmt.Errorf("%w: column %q: invalid column %q", ErrInvalidSchema, key, col.Col)
+			}
+		}
+
+		fields = append(fields, reflect.StructField{Name: "Column" + strconv.Itoa(i), Type: m.field.Type})
+		keys = append(keys, key)
+		mappings = append(mappings, m)
+	}
+
+	return reflect.StructOf(fields), keys, mappings, nil
+}
+
+// converter returns the type of the column's values, and the convertFunc creating pointers to them,
+// which applies the layout, the default value and the validation of the column. The default value is converted
+// and validated once, with the layout or the given date-time formats. If neither is given for a time column,
+// the default value is converted along with the cells instead.
+func (c Column) converter(dateTimeFormats []string) (reflect.Type, convertFunc, error) {
+	name := c.Type
+	if name == "" {
+		name = "string"
+	}
+	typ, ok := schemaTypes[name]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w: column %q of type %q", ErrUnsupportedType, c.Name, c.Type)
+	}
+
+	convert := makeConvertFunc(typ, true)
+	if c.Layout != "" {
+		if typ != timeType {
+			return nil, nil, fmt.Errorf("%w: column %q of type %q cannot have a layout", ErrInvalidSchema, c.Name, name)
+		}
+		dateTimeFormats = []string{c.Layout}
+		parse := convert
+		convert = func(cv string, _ []string) (reflect.Value, bool, error) {
+			return parse(cv, dateTimeFormats)
+		}
+	}
+
+	validators := make([]Validator, 0, len(c.Validate)+len(c.Validators))
+	for _, rule := range c.Validate {
+		validate, err := parseRule(rule, typ)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w: column %q: %w", ErrInvalidSchema, c.Name, err)
+		}
+		validators = append(validators, validate)
+	}
+	validators = append(validators, c.Validators...)
+	if len(validators) > 0 {
+		parse := convert
+		convert = func(cv string, dateTimeFormats []string) (reflect.Value, bool, error) {
+			val, nonEmpty, err := parse(cv, dateTimeFormats)
+			if err != nil || !nonEmpty {
+				return val, nonEmpty, err
+			}
+			for _, validate := range validators {
+				if err := validate(val.Elem().Interface()); err != nil {
+					if !errors.Is(err, ErrInvalidValue) {
+						err = fmt.Errorf("%w: %w", ErrInvalidValue, err)
+					}
+					return errVal, false, err
+				}
+			}
+			return val, true, nil
+		}
+	}
+
+	if c.Default == "" || (typ == timeType && len(dateTimeFormats) == 0) {
+		return typ, wrapEmpty(reflect.PointerTo(typ), convert, c.Default, c.Default != "", c.Required), nil
+	}
+
+	def, _, err := convert(c.Default, dateTimeFormats)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: column %q: invalid default value %q: %w", ErrInvalidSchema, c.Name, c.Default, err)
+	}
+	return typ, wrapDefault(convert, def), nil
+}
+
+// parseRule creates the Validator for the given rule of a column with the given type, see Column.Validate.
+func parseRule(rule string, typ reflect.Type) (Validator, error) {
+	name, arg, _ := strings.Cut(rule, "=")
+	switch name {
+	case "min", "max":
+		limit, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid limit of rule %q: %w", rule, err)
+		}
+		size := sizeOf(typ)
+		if size == nil {
+			return nil, fmt.Errorf("rule %q is unsupported for type %q", rule, typ)
+		}
+		return func(value any) error {
+			switch n := size(value); {
+			case name == "min" && n < limit:
+				return fmt.Errorf("%w: %v is less than %s", ErrInvalidValue, value, arg)
+			case name == "max" && n > limit:
+				return fmt.Errorf("%w: %v is greater than %s", ErrInvalidValue, value, arg)
+			}
+			return nil
+		}, nil
+	case "oneof":
+		values := splitEscaped(arg, aliasSeparator)
+		for i, v := range values {
+			values[i] = unescapeTag(v)
+		}
+		return func(value any) error {
+			if !slices.Contains(values, fmt.Sprint(value)) {
+				return fmt.Errorf("%w: %v is not one of %q", ErrInvalidValue, value, values)
+			}
+			return nil
+		}, nil
+	case "pattern":
+		if typ.Kind() != reflect.String {
+			return nil, fmt.Errorf("rule %q is unsupported for type %q", rule, typ)
+		}
+		re, err := regexp.Compile(arg)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern of rule %q: %w", rule, err)
+		}
+		return func(value any) error {
+			if !re.MatchString(value.(string)) {
+				return fmt.Errorf("%w: %q does not match %q", ErrInvalidValue, value, arg)
+			}
+			return nil
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown rule %q", rule)
+	}
+}
+
+// sizeOf returns a function measuring the values of the given type for the min and max rules,
+// or nil if the type cannot be measured.
+func sizeOf(typ reflect.Type) func(any) float64 {
+	switch typ.Kind() {
+	case reflect.String:
+		return func(v any) float64 { return float64(utf8.RuneCountInString(v.(string))) }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(v any) float64 { return float64(reflect.ValueOf(v).Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(v any) float64 { return float64(reflect.ValueOf(v).Uint()) }
+	case reflect.Float32, reflect.Float64:
+		return func(v any) float64 { return reflect.ValueOf(v).Float() }
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,208 @@
+package gsheets
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestParseSchema(t *testing.T) {
+	t.Run("yaml", func(t *testing.T) {
+		t.Parallel()
+
+		schema, err := ParseSchema([]byte(`
+name: orders
+columns:
+  - name: SKU
+    required: true
+    validate: ["pattern=^[A-Z]+$"]
+  - name: Ordered At
+    type: time
+    layout: "02.01.2006"
+`))
+		require.NoError(t, err)
+		assert.Equal(t, Schema{Name: "orders", Columns: []Column{
+			{Name: "SKU", Required: true, Validate: []string{"pattern=^[A-Z]+$"}},
+			{Name: "Ordered At", Type: "time", Layout: "02.01.2006"},
+		}}, schema)
+	})
+
+	t.Run("json file", func(t *testing.T) {
+		t.Parallel()
+
+		path := filepath.Join(t.TempDir(), "schema.json")
+		require.NoError(t, os.WriteFile(path, []byte(`{"columns": [{"name": "Quantity", "type": "int", "default": "1"}]}`), 0o600))
+
+		schema, err := LoadSchema(path)
+		require.NoError(t, err)
+		assert.Equal(t, Schema{Columns: []Column{{Name: "Quantity", Type: "int", Default: "1"}}}, schema)
+	})
+
+	t.Run("invalid schemas", func(t *testing.T) {
+		t.Parallel()
+
+		for name, tt := range map[string]struct {
+			doc string
+			err error
+		}{
+			"unknown field":     {`columns: [{name: SKU, typ: int}]`, ErrInvalidSchema},
+			"no columns":        {`name: orders`, ErrInvalidSchema},
+			"duplicate column":  {`columns: [{name: SKU}, {name: SKU|Article}]`, ErrInvalidSchema},
+			"unsupported type":  {`columns: [{name: SKU, type: complex64}]`, ErrUnsupportedType},
+			"layout of int":     {`columns: [{name: SKU, type: int, layout: "2006"}]`, ErrInvalidSchema},
+			"unknown rule":      {`columns: [{name: SKU, validate: [positive]}]`, ErrInvalidSchema},
+			"pattern of int":    {`columns: [{name: SKU, type: int, validate: ["pattern=^1$"]}]`, ErrInvalidSchema},
+			"min of bool":       {`columns: [{name: SKU, type: bool, validate: ["min=1"]}]`, ErrInvalidSchema},
+			"invalid column":    {`columns: [{name: SKU, col: "0"}]`, ErrInvalidSchema},
+			"invalid min limit": {`columns: [{name: SKU, validate: ["min=x"]}]`, ErrInvalidSchema},
+			"invalid default":   {`columns: [{name: SKU, type: int, default: x}]`, ErrInvalidSchema},
+			"invalid default value": {
+				`columns: [{name: SKU, type: int, default: "0", validate: ["min=1"]}]`, ErrInvalidSchema,
+			},
+		} {
+			t.Run(name, func(t *testing.T) {
+				_, err := ParseSchema([]byte(tt.doc))
+				assert.ErrorIs(t, err, tt.err)
+			})
+		}
+	})
+}
+
+func TestParseSheetIntoMaps(t *testing.T) {
+	cfg := MakeConfig(_svc, "test-workbook", WithSheetName("orders"))
+	schema := Schema{Name: "orders", Columns: []Column{
+		{Name: "SKU|Article", Required: true, Validate: []string{"pattern=^[A-Z]+$", "max=5"}},
+		{Name: "Quantity", Type: "int", Default: "1", Validate: []string{"min=1"}},
+		{Name: "Status", Validate: []string{"oneof=open|paid"}},
+		{Name: "Ordered At", Type: "time", Layout: "02.01.2006"},
+		{Name: "Note", Validators: []Validator{func(value any) error {
+			if value == "spam" {
+				return errors.New("spam is not allowed")
+			}
+			return nil
+		}}},
+	}}
+	fetcher := func(rows ...[]any) ConfigOption {
+		return withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{
+				Values: append([][]any{{"Article", "Quantity", "Status", "Ordered At", "Note"}}, rows...),
+			}, nil
+		})
+	}
+
+	t.Run("error from fetch method", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseSheetIntoMaps(cfg, schema, withFetch(errorFetcher))
+		assert.ErrorIs(t, err, fetcherError)
+	})
+
+	t.Run("invalid schema", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseSheetIntoMaps(cfg, Schema{}, fetcher())
+		assert.ErrorIs(t, err, ErrInvalidSchema)
+	})
+
+	t.Run("sheet name", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseSheetIntoMaps(MakeConfig(_svc, "test-workbook"), Schema{Columns: schema.Columns}, fetcher())
+		assert.ErrorIs(t, err, ErrNoSheetName)
+
+		_, err = ParseSheetIntoMaps(MakeConfig(_svc, "test-workbook"), Schema{Name: "Order", Columns: schema.Columns},
+			withFetch(func(cfg Config) (*sheets.ValueRange, error) {
+				assert.Equal(t, "Orders", cfg.sheetName)
+				return &sheets.ValueRange{}, nil
+			}),
+		)
+		assert.Error(t, err)
+	})
+
+	t.Run("duplicate normalized column", func(t *testing.T) {
+		t.Parallel()
+
+		schema := Schema{Columns: []Column{{Name: "Ordered At"}, {Name: "ordered_at"}}}
+		_, err := ParseSheetIntoMaps(cfg, schema, fetcher(), WithHeaderMatcher(MatchNormalized))
+		assert.ErrorIs(t, err, ErrInvalidSchema)
+	})
+
+	t.Run("escaped pipes", func(t *testing.T) {
+		t.Parallel()
+
+		schema := Schema{Columns: []Column{
+			{Name: `In\|Out|Article`, Validate: []string{`oneof=A\|B|C`}},
+		}}
+		items, err := ParseSheetIntoMapSlice(cfg, schema, withFetch(func(Config) (*sheets.ValueRange, error) {
+			return &sheets.ValueRange{Values: [][]any{{"In|Out"}, {"A|B"}}}, nil
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{{"In|Out": "A|B"}}, items)
+	})
+
+	t.Run("missing column", func(t *testing.T) {
+		t.Parallel()
+
+		schema := Schema{Columns: append(schema.Columns, Column{Name: "Customer"})}
+		_, err := ParseSheetIntoMaps(cfg, schema, fetcher())
+		var mismatch *SchemaMismatchError
+		require.ErrorAs(t, err, &mismatch)
+		assert.Equal(t, []MissingField{{Field: "Schema.Customer", Name: "Customer"}}, mismatch.MissingFields)
+	})
+
+	t.Run("maps", func(t *testing.T) {
+		t.Parallel()
+
+		items, err := ParseSheetIntoMapSlice(cfg, schema, fetcher(
+			[]any{"ABC", "3", "paid", "24.12.2025", "gift"},
+			[]any{"DEF", "", "", "", ""},
+		))
+		require.NoError(t, err)
+		assert.Equal(t, []map[string]any{
+			{"SKU": "ABC", "Quantity": 3, "Status": "paid", "Ordered At": time.Date(2025, time.December, 24, 0, 0, 0, 0, time.UTC), "Note": "gift"},
+			{"SKU": "DEF", "Quantity": 1},
+		}, items)
+	})
+
+	t.Run("validation errors", func(t *testing.T) {
+		t.Parallel()
+
+		for name, tt := range map[string]struct {
+			row  []any
+			cell string
+			err  error
+		}{
+			"required":  {[]any{"", "1"}, "A2", ErrRequiredValue},
+			"pattern":   {[]any{"abc", "1"}, "A2", ErrInvalidValue},
+			"max":       {[]any{"ABCDEF", "1"}, "A2", ErrInvalidValue},
+			"min":       {[]any{"ABC", "0"}, "B2", ErrInvalidValue},
+			"oneof":     {[]any{"ABC", "1", "lost"}, "C2", ErrInvalidValue},
+			"layout":    {[]any{"ABC", "1", "open", "2025-12-24"}, "D2", new(InvalidDateTimeFormatError)},
+			"validator": {[]any{"ABC", "1", "open", "", "spam"}, "E2", ErrInvalidValue},
+		} {
+			t.Run(name, func(t *testing.T) {
+				results, err := ParseSheetIntoMaps(cfg, schema, fetcher(tt.row))
+				require.NoError(t, err)
+
+				for rowIdx, item := range results {
+					assert.Equal(t, 2, rowIdx)
+
+					var mappingErr *MappingError
+					require.ErrorAs(t, item.Err, &mappingErr)
+					assert.Equal(t, tt.cell, mappingErr.Cell)
+					if target, ok := tt.err.(*InvalidDateTimeFormatError); ok {
+						assert.ErrorAs(t, item.Err, &target)
+					} else {
+						assert.ErrorIs(t, item.Err, tt.err)
+					}
+				}
+			})
+		}
+	})
+}
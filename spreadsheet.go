@@ -0,0 +1,154 @@
+package gsheets
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/api/sheets/v4"
+)
+
+// ParseSpreadsheet parses multiple sheets of a spreadsheet into a struct of slices, e.g.
+//
+//	type Catalog struct {
+//		Products []Product `gsheets:"Products"`
+//		Prices   []*Price
+//	}
+//
+// Each exported slice field of structs, or pointers to structs, is filled from the sheet named by its tag,
+// or by the field name if no name is given. All sheets are fetched in a single batch request,
+// and each of them is parsed like with ParseSheetIntoStructSlice.
+// Errors are aggregated per sheet as *SheetError, while the slices of all other sheets are still filled.
+// Rows skipped within an error budget are reported the same way, but their sheet's slice is filled, too.
+func ParseSpreadsheet[T any](cfg Config, opts ...ConfigOption) (T, error) {
+	var out T
+	cfg, err := cfg.init(reflect.TypeFor[T](), opts)
+	if err != nil {
+		return out, err
+	}
+
+	fields, err := readSheetTags(cfg.tagName, reflect.TypeFor[T]())
+	if err != nil {
+		return out, err
+	}
+
+	ranges := make([]string, 0, len(fields))
+	for _, s := range fields {
+		ranges = append(ranges, s.name)
+	}
+
+	resp, err := cfg.batchFetch(cfg, ranges)
+	if err != nil {
+		return out, err
+	}
+
+	ref := reflect.ValueOf(&out).Elem()
+	var errs []error
+	for i, s := range fields {
+		sheetCfg := cfg
+		sheetCfg.sheetName = s.name
+		if err := sheetCfg.loadMerges(); err != nil {
+			errs = append(errs, &SheetError{Sheet: s.name, err: err})
+			continue
+		}
+
+		var values [][]any
+		if i < len(resp) && resp[i] != nil {
+			values = resp[i].Values
+		}
+
+		if err := s.parse(sheetCfg, ref, values); err != nil {
+			errs = append(errs, &SheetError{Sheet: s.name, err: err})
+		}
+	}
+
+	return out, errors.Join(errs...)
+}
+
+// sheetField describes a slice field of a spreadsheet struct, which is filled from a single sheet.
+type sheetField struct {
+	field     reflect.StructField
+	name      string
+	elem      reflect.Type
+	isPointer bool
+}
+
+// parse converts the given values of the sheet and sets the resulting slice to the field of the given struct value.
+func (s sheetField) parse(cfg Config, ref reflect.Value, values [][]any) error {
+	results, rows, err := parseValuesOf(s.elem, cfg, values)
+	if err != nil {
+		return err
+	}
+
+	items, err := collectResults(cfg, results, rows)
+	if err != nil && !errors.Is(err, ErrRowsSkipped) {
+		return err
+	}
+
+	slice := reflect.MakeSlice(s.field.Type, 0, len(items))
+	for _, item := range items {
+		if s.isPointer {
+			item = item.Addr()
+		}
+		slice = reflect.Append(slice, item)
+	}
+	ref.FieldByIndex(s.field.Index).Set(slice)
+
+	return err
+}
+
+// readSheetTags returns a sheetField for each exported field of the given spreadsheet struct.
+func readSheetTags(tagName string, t reflect.Type) ([]sheetField, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: spreadsheet %q must be a struct", ErrUnsupportedType, t)
+	}
+
+	out := make([]sheetField, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+
+		s := sheetField{field: f, name: f.Name}
+		if v, ok := f.Tag.Lookup(tagName); ok {
+			name := unescapeTag(splitEscaped(v, ",")[0])
+			if name == "-" {
+				continue
+			}
+			if name != "" {
+				s.name = name
+			}
+		}
+
+		if f.Type.Kind() == reflect.Slice {
+			s.elem, s.isPointer = indirect(f.Type.Elem())
+		}
+		if s.elem == nil || s.elem.Kind() != reflect.Struct || s.elem == timeType {
+			return nil, fmt.Errorf("%w: field %q of type %q must be a slice of structs", ErrUnsupportedType, f.Name, f.Type)
+		}
+
+		out = append(out, s)
+	}
+
+	if len(out) == 0 {
+		return nil, ErrNoMapping
+	}
+
+	return out, nil
+}
+
+type batchFetchFN func(cfg Config, ranges []string) ([]*sheets.ValueRange, error)
+
+func batchFetchViaGoogleAPI(cfg Config, ranges []string) ([]*sheets.ValueRange, error) {
+	resp, err := cfg.Service.Spreadsheets.Values.BatchGet(cfg.spreadsheetID).
+		Ranges(ranges...).
+		Context(cfg.Context()).
+		MajorDimension(string(cfg.majorDimension)).
+		Do()
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.ValueRanges, nil
+}
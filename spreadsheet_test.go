@@ -0,0 +1,113 @@
+package gsheets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestParseSpreadsheet(t *testing.T) {
+	type productT struct {
+		SKU  string
+		Name string
+	}
+	type priceT struct {
+		SKU   string
+		Price float64
+	}
+	type catalogT struct {
+		Products []productT `gsheets:"Products"`
+		Prices   []*priceT
+		Ignored  []string `gsheets:"-"`
+		internal []productT
+	}
+
+	cfg := MakeConfig(_svc, "test-workbook")
+
+	t.Run("errors", func(t *testing.T) {
+		t.Run("no service", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSpreadsheet[catalogT](Config{}, withBatchFetch(errorBatchFetcher))
+			assert.ErrorIs(t, err, ErrNoService)
+		})
+
+		t.Run("error from fetch method", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSpreadsheet[catalogT](cfg, withBatchFetch(errorBatchFetcher))
+			assert.ErrorIs(t, err, fetcherError)
+		})
+
+		t.Run("unsupported field", func(t *testing.T) {
+			t.Parallel()
+
+			type invalidT struct {
+				Products []productT
+				Settings productT
+			}
+
+			_, err := ParseSpreadsheet[invalidT](cfg, withBatchFetch(errorBatchFetcher))
+			assert.ErrorIs(t, err, ErrUnsupportedType)
+		})
+
+		t.Run("no sheets", func(t *testing.T) {
+			t.Parallel()
+
+			type emptyT struct{}
+
+			_, err := ParseSpreadsheet[emptyT](cfg, withBatchFetch(errorBatchFetcher))
+			assert.ErrorIs(t, err, ErrNoMapping)
+		})
+
+		t.Run("errors per sheet", func(t *testing.T) {
+			t.Parallel()
+
+			catalog, err := ParseSpreadsheet[catalogT](cfg,
+				withBatchFetch(func(Config, []string) ([]*sheets.ValueRange, error) {
+					return []*sheets.ValueRange{
+						{Values: [][]any{{"SKU", "Name"}, {"A-1", "Apple"}}},
+						{Values: [][]any{{"SKU", "Price"}, {"A-1", "cheap"}}},
+					}, nil
+				}),
+			)
+			var sheetErr *SheetError
+			require.ErrorAs(t, err, &sheetErr)
+			assert.Equal(t, "Prices", sheetErr.Sheet)
+
+			var mappingErr *MappingError
+			require.ErrorAs(t, err, &mappingErr)
+			assert.Equal(t, "Prices", mappingErr.Sheet)
+			assert.Equal(t, "B2", mappingErr.Cell)
+
+			assert.Equal(t, []productT{{SKU: "A-1", Name: "Apple"}}, catalog.Products)
+			assert.Nil(t, catalog.Prices)
+		})
+	})
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		catalog, err := ParseSpreadsheet[catalogT](cfg,
+			withBatchFetch(func(cfg Config, ranges []string) ([]*sheets.ValueRange, error) {
+				assert.Equal(t, "test-workbook", cfg.spreadsheetID)
+				assert.Equal(t, []string{"Products", "Prices"}, ranges)
+				return []*sheets.ValueRange{
+					{Values: [][]any{{"SKU", "Name"}, {"A-1", "Apple"}, {"B-2", "Banana"}}},
+					{Values: [][]any{{"SKU", "Price"}, {"A-1", "1.5"}}},
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, catalogT{
+			Products: []productT{{SKU: "A-1", Name: "Apple"}, {SKU: "B-2", Name: "Banana"}},
+			Prices:   []*priceT{{SKU: "A-1", Price: 1.5}},
+		}, catalog)
+	})
+}
+
+func errorBatchFetcher(Config, []string) ([]*sheets.ValueRange, error) {
+	return nil, fetcherError
+}
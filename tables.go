@@ -0,0 +1,167 @@
+package gsheets
+
+import (
+	"iter"
+	"reflect"
+	"slices"
+)
+
+// Table is a single table within a sheet, which contains several stacked tables.
+// It can be parsed via ParseTableIntoStructs or ParseTableIntoStructSlice, with its own type.
+type Table struct {
+	// Title holds the title of the table, i.e. the matching anchor, or the caption above the header.
+	// It is empty, if the table has no title.
+	Title string
+	// Row holds the 1-based number of the first header row of the table.
+	Row int
+
+	cfg    Config
+	values [][]any
+}
+
+// ParseSheetIntoTables fetches a sheet, which contains several stacked tables, and splits it into its tables.
+// By default, the tables are separated by blank rows. The first row of a table is treated as its title,
+// if only its first cell is non-empty, while the row below it holds multiple captions.
+// If anchors are defined via WithTableAnchors, each table starts below its anchor instead, and may contain blank rows.
+// The name of the sheet must be given via WithSheetName, otherwise ErrNoSheetName is returned.
+func ParseSheetIntoTables(cfg Config, opts ...ConfigOption) ([]Table, error) {
+	cfg, err := cfg.init(nil, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := cfg.fetch(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := cfg.loadMerges(); err != nil {
+		return nil, err
+	}
+
+	var tables []Table
+	if len(cfg.tableAnchors) > 0 {
+		tables = splitTablesByAnchors(resp.Values, cfg)
+	} else {
+		tables = splitTablesByBlankRows(resp.Values)
+	}
+
+	for i := range tables {
+		tables[i].cfg = cfg
+		tables[i].cfg.recordOffset = tables[i].Row - 1
+	}
+
+	return tables, cfg.Context().Err()
+}
+
+// ParseTableIntoStructs parses a table of a sheet, and returns an iterator over the parsing Result.
+// The yielded index is the 1-based row number within the sheet. See ParseSheetIntoStructs for details.
+func ParseTableIntoStructs[T any](table Table, opts ...ConfigOption) (iter.Seq2[int, Result[T]], error) {
+	cfg, err := table.cfg.init(reflect.TypeFor[T](), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	results, _, err := parseValues[T](cfg, slices.Clone(table.values))
+	return results, err
+}
+
+// ParseTableIntoStructSlice parses a table of a sheet, and returns a slice of structs with the given type.
+// See ParseSheetIntoStructSlice for details.
+func ParseTableIntoStructSlice[T any](table Table, opts ...ConfigOption) ([]T, error) {
+	cfg, err := table.cfg.init(reflect.TypeFor[T](), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	results, rows, err := parseValues[T](cfg, slices.Clone(table.values))
+	if err != nil {
+		return nil, err
+	}
+
+	return collectResults(cfg, results, rows)
+}
+
+// splitTablesByBlankRows splits the given values into blocks of consecutive non-blank rows.
+func splitTablesByBlankRows(values [][]any) []Table {
+	var tables []Table
+	start := -1
+	for rowIdx := 0; rowIdx <= len(values); rowIdx++ {
+		if rowIdx < len(values) && !isBlankRow(values[rowIdx]) {
+			if start < 0 {
+				start = rowIdx
+			}
+			continue
+		}
+		if start < 0 {
+			continue
+		}
+
+		block := values[start:rowIdx]
+		table := Table{Row: start + 1}
+		if len(block) > 1 && isTitleRow(block[0]) && !isTitleRow(block[1]) {
+			table.Title = block[0][0].(string)
+			table.Row++
+			block = block[1:]
+		}
+		table.values = block
+		tables = append(tables, table)
+		start = -1
+	}
+
+	return tables
+}
+
+// splitTablesByAnchors splits the given values at the rows, whose first cell matches one of the configured anchors.
+// Blank rows between the anchor and the header, and at the end of a table, are removed.
+func splitTablesByAnchors(values [][]any, cfg Config) []Table {
+	anchors := make(map[string]struct{}, len(cfg.tableAnchors))
+	for _, anchor := range cfg.tableAnchors {
+		anchors[cfg.headerKey(anchor)] = struct{}{}
+	}
+
+	var tables []Table
+	for rowIdx, row := range values {
+		if len(row) > 0 {
+			if _, ok := anchors[cfg.headerKey(row[0].(string))]; ok {
+				tables = append(tables, Table{Title: row[0].(string), Row: rowIdx + 2})
+				continue
+			}
+		}
+		if len(tables) == 0 {
+			continue
+		}
+
+		table := &tables[len(tables)-1]
+		if len(table.values) == 0 && isBlankRow(row) {
+			table.Row++
+			continue
+		}
+		table.values = append(table.values, row)
+	}
+
+	for i, table := range tables {
+		end := len(table.values)
+		for end > 0 && isBlankRow(table.values[end-1]) {
+			end--
+		}
+		tables[i].values = table.values[:end]
+	}
+
+	return tables
+}
+
+// isBlankRow reports whether all cells of the given row are empty.
+func isBlankRow(row []any) bool {
+	for _, cell := range row {
+		if cell.(string) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// isTitleRow reports whether only the first cell of the given row is non-empty.
+func isTitleRow(row []any) bool {
+	return len(row) > 0 && row[0].(string) != "" && isBlankRow(row[1:])
+}
@@ -0,0 +1,144 @@
+package gsheets
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/sheets/v4"
+)
+
+func TestParseSheetIntoTables(t *testing.T) {
+	type productT struct {
+		SKU  string
+		Name string
+	}
+	type priceT struct {
+		SKU   string
+		Price float64
+	}
+
+	cfg := MakeConfig(_svc, "test-workbook", WithSheetName("overview"))
+
+	t.Run("error from fetch method", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseSheetIntoTables(cfg, withFetch(errorFetcher))
+		assert.ErrorIs(t, err, fetcherError)
+	})
+
+	t.Run("no sheet name", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseSheetIntoTables(MakeConfig(_svc, "test-workbook"), withFetch(errorFetcher))
+		assert.ErrorIs(t, err, ErrNoSheetName)
+	})
+
+	t.Run("options of the table", func(t *testing.T) {
+		t.Parallel()
+
+		type datedT struct {
+			Date time.Time
+		}
+
+		tables, err := ParseSheetIntoTables(cfg, WithDatetimeFormats("2.1.2006"),
+			withFetch(func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{Values: [][]any{{"Date"}, {"never"}}}, nil
+			}),
+		)
+		require.NoError(t, err)
+		require.Len(t, tables, 1)
+
+		_, err = ParseTableIntoStructSlice[datedT](tables[0], WithCollectRowErrors(true))
+		var dateErr *InvalidDateTimeFormatError
+		require.ErrorAs(t, err, &dateErr)
+		assert.Equal(t, append([]string{"2.1.2006"}, dateTimeFormats[:]...), dateErr.Formats)
+
+		var mappingErr *MappingError
+		require.ErrorAs(t, err, &mappingErr)
+		assert.Equal(t, "overview", mappingErr.Sheet)
+	})
+
+	t.Run("blank rows", func(t *testing.T) {
+		t.Parallel()
+
+		tables, err := ParseSheetIntoTables(cfg,
+			withFetch(func(cfg Config) (*sheets.ValueRange, error) {
+				assert.Equal(t, "overview", cfg.sheetName)
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"Products"},
+						{"SKU", "Name"},
+						{"A-1", "Apple"},
+						{"B-2", "Banana"},
+						{},
+						{"", ""},
+						{"SKU", "Price"},
+						{"A-1", "cheap"},
+					},
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+		require.Len(t, tables, 2)
+		assert.Equal(t, "Products", tables[0].Title)
+		assert.Equal(t, 2, tables[0].Row)
+		assert.Equal(t, "", tables[1].Title)
+		assert.Equal(t, 7, tables[1].Row)
+
+		products, err := ParseTableIntoStructSlice[productT](tables[0])
+		require.NoError(t, err)
+		assert.Equal(t, []productT{{SKU: "A-1", Name: "Apple"}, {SKU: "B-2", Name: "Banana"}}, products)
+
+		results, err := ParseTableIntoStructs[priceT](tables[1])
+		require.NoError(t, err)
+		for rowIdx, result := range results {
+			assert.Equal(t, 8, rowIdx)
+
+			var mappingErr *MappingError
+			require.ErrorAs(t, result.Err, &mappingErr)
+			assert.Equal(t, "B8", mappingErr.Cell)
+		}
+	})
+
+	t.Run("anchors", func(t *testing.T) {
+		t.Parallel()
+
+		tables, err := ParseSheetIntoTables(cfg,
+			WithTableAnchors("products", "prices"),
+			WithHeaderMatcher(MatchCaseInsensitive),
+			withFetch(func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"Overview of the catalog"},
+						{"Products"},
+						{},
+						{"SKU", "Name"},
+						{"A-1", "Apple"},
+						{"", ""},
+						{"B-2", "Banana"},
+						{},
+						{"Prices"},
+						{"SKU", "Price"},
+						{"A-1", "1.5"},
+					},
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+		require.Len(t, tables, 2)
+		assert.Equal(t, "Products", tables[0].Title)
+		assert.Equal(t, 4, tables[0].Row)
+		assert.Equal(t, "Prices", tables[1].Title)
+		assert.Equal(t, 10, tables[1].Row)
+
+		products, err := ParseTableIntoStructSlice[productT](tables[0])
+		require.NoError(t, err)
+		assert.Equal(t, []productT{{SKU: "A-1", Name: "Apple"}, {SKU: "B-2", Name: "Banana"}}, products)
+
+		prices, err := ParseTableIntoStructSlice[priceT](tables[1])
+		require.NoError(t, err)
+		assert.Equal(t, []priceT{{SKU: "A-1", Price: 1.5}}, prices)
+	})
+}
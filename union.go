@@ -0,0 +1,132 @@
+package gsheets
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"reflect"
+	"slices"
+)
+
+// ParseSheetIntoUnions parses a sheet, which mixes different kinds of records distinguished by a discriminator
+// column, and returns an iterator over the parsing Result.
+// The types map each value of the discriminator column to a prototype of the record, e.g.
+//
+//	map[string]Event{"order": Order{}, "refund": &Refund{}}
+//
+// Each row is converted into a new value of the prototype's type, which is yielded as the interface type T.
+// Pointer prototypes yield pointers. All types are mapped to the same header, and rows with an unknown
+// discriminator value yield a *MappingError wrapping ErrUnknownDiscriminator.
+// See ParseSheetIntoStructs for details.
+func ParseSheetIntoUnions[T any](cfg Config, column string, types map[string]T, opts ...ConfigOption) (iter.Seq2[int, Result[T]], error) {
+	results, _, _, err := parseUnions(cfg, column, types, opts)
+	return results, err
+}
+
+// ParseSheetIntoUnionSlice parses a sheet, which mixes different kinds of records distinguished by a discriminator
+// column, and returns a slice of interface values. See ParseSheetIntoUnions and ParseSheetIntoStructSlice for details.
+func ParseSheetIntoUnionSlice[T any](cfg Config, column string, types map[string]T, opts ...ConfigOption) ([]T, error) {
+	results, cfg, rows, err := parseUnions(cfg, column, types, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectResults(cfg, results, rows)
+}
+
+// unionType holds the mappings of a single type of a discriminated union.
+type unionType struct {
+	t         reflect.Type
+	isPointer bool
+	mappings  []*mapping
+}
+
+func parseUnions[T any](cfg Config, column string, types map[string]T, opts []ConfigOption) (iter.Seq2[int, Result[T]], Config, int, error) {
+	cfg, err := cfg.init(reflect.TypeFor[T](), opts)
+	if err != nil {
+		return nil, cfg, 0, err
+	}
+
+	resp, err := cfg.fetch(cfg)
+	if err != nil {
+		return nil, cfg, 0, err
+	}
+
+	if err := cfg.loadMerges(); err != nil {
+		return nil, cfg, 0, err
+	}
+
+	captions, data := splitHeader(cfg, resp.Values)
+	header := newSheetHeader(captions, cfg)
+	idxs, ok := header.colNames[cfg.headerKey(column)]
+	if !ok {
+		return nil, cfg, 0, &SchemaMismatchError{Sheet: cfg.sheetName, MissingFields: []MissingField{{Name: column}}}
+	}
+	discriminator := idxs[0]
+
+	// unknown columns are checked for all types together
+	mappingCfg := cfg
+	mappingCfg.allowSkipColumns = true
+	mappingCfg.multipleTypes = true
+
+	union := make(map[string]unionType, len(types))
+	mapped := map[int]bool{discriminator: true}
+	var all [][]*mapping
+	for _, value := range slices.Sorted(maps.Keys(types)) {
+		typ := reflect.TypeOf(types[value])
+		if typ == nil {
+			return nil, cfg, 0, fmt.Errorf("%w: no type registered for %q", ErrUnsupportedType, value)
+		}
+
+		ut := unionType{}
+		ut.t, ut.isPointer = indirect(typ)
+		if ut.mappings, err = createMappings(ut.t, captions, mappingCfg); err != nil {
+			return nil, cfg, 0, err
+		}
+		for colIdx := range mappedColumns(ut.mappings) {
+			mapped[colIdx] = true
+		}
+		union[value] = ut
+		all = append(all, ut.mappings)
+	}
+
+	if err := checkUnknownColumns(captions, cfg, mapped); err != nil {
+		return nil, cfg, 0, err
+	}
+
+	rows, n := tableRows(cfg, resp.Values, data, all...)
+	return func(yield func(int, Result[T]) bool) {
+		for rowIdx, row := range rows {
+			value := row.cells[discriminator].(string)
+			ut, ok := union[value]
+			if !ok {
+				err := &MappingError{
+					Sheet:  cfg.sheetName,
+					Cell:   cfg.cellName(discriminator, rowIdx),
+					Header: header.captions[discriminator],
+					Value:  value,
+					err:    ErrUnknownDiscriminator,
+				}
+				if !yield(rowIdx, Result[T]{Err: err}) {
+					return
+				}
+				continue
+			}
+
+			item := reflect.New(ut.t).Elem()
+			if err := convertRow(item, row, rowIdx, ut.mappings, cfg); err != nil {
+				if !yield(rowIdx, Result[T]{Err: err}) {
+					return
+				}
+				continue
+			}
+
+			if ut.isPointer {
+				item = item.Addr()
+			}
+			if !yield(rowIdx, Result[T]{Val: item.Interface().(T)}) {
+				return
+			}
+		}
+	}, cfg, n, cfg.Context().Err()
+}
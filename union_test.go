@@ -0,0 +1,135 @@
+package gsheets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/sheets/v4"
+)
+
+type eventT interface {
+	event()
+}
+
+type orderEventT struct {
+	ID     string
+	Amount float64
+}
+
+func (orderEventT) event() {}
+
+type refundEventT struct {
+	ID     string
+	Reason string
+}
+
+func (*refundEventT) event() {}
+
+func TestParseSheetIntoUnions(t *testing.T) {
+	types := map[string]eventT{
+		"order":  orderEventT{},
+		"refund": &refundEventT{},
+	}
+
+	cfg := MakeConfig(_svc, "test-workbook", WithSheetName("events"), WithAllowSkipFields(true))
+	fetcher := withFetch(func(Config) (*sheets.ValueRange, error) {
+		return &sheets.ValueRange{
+			Values: [][]any{
+				{"Type", "ID", "Amount", "Reason"},
+				{"order", "1", "9.99"},
+				{"refund", "1", "", "damaged"},
+				{"voucher", "2", "5"},
+				{"order", "3", "free"},
+			},
+		}, nil
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Run("error from fetch method", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoUnions(cfg, "Type", types, withFetch(errorFetcher))
+			assert.ErrorIs(t, err, fetcherError)
+		})
+
+		t.Run("missing discriminator", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoUnions(cfg, "Kind", types, fetcher)
+			var mismatchErr *SchemaMismatchError
+			require.ErrorAs(t, err, &mismatchErr)
+			assert.Equal(t, []MissingField{{Name: "Kind"}}, mismatchErr.MissingFields)
+		})
+
+		t.Run("unknown column", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoUnions(cfg, "Type", map[string]eventT{"order": orderEventT{}}, fetcher)
+			var mismatchErr *SchemaMismatchError
+			require.ErrorAs(t, err, &mismatchErr)
+			assert.Equal(t, []UnknownColumn{{Name: "Reason", Column: "D", Index: 3}}, mismatchErr.UnknownColumns)
+		})
+
+		t.Run("nil prototype", func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseSheetIntoUnions(cfg, "Type", map[string]eventT{"order": nil}, fetcher)
+			assert.ErrorIs(t, err, ErrUnsupportedType)
+		})
+	})
+
+	t.Run("iterator", func(t *testing.T) {
+		t.Parallel()
+
+		results, err := ParseSheetIntoUnions(cfg, "Type", types, fetcher)
+		require.NoError(t, err)
+
+		var events []eventT
+		var errs []error
+		for _, result := range results {
+			if result.Err != nil {
+				errs = append(errs, result.Err)
+				continue
+			}
+			events = append(events, result.Val)
+		}
+
+		assert.Equal(t, []eventT{orderEventT{ID: "1", Amount: 9.99}, &refundEventT{ID: "1", Reason: "damaged"}}, events)
+		require.Len(t, errs, 2)
+
+		var mappingErr *MappingError
+		require.ErrorAs(t, errs[0], &mappingErr)
+		assert.ErrorIs(t, errs[0], ErrUnknownDiscriminator)
+		assert.Equal(t, "A4", mappingErr.Cell)
+		assert.Equal(t, "voucher", mappingErr.Value)
+
+		require.ErrorAs(t, errs[1], &mappingErr)
+		assert.Equal(t, "C5", mappingErr.Cell)
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		t.Parallel()
+
+		events, err := ParseSheetIntoUnionSlice(cfg, "Type", types, fetcher, WithMaxErrors(2))
+		assert.ErrorIs(t, err, ErrRowsSkipped)
+		assert.Len(t, events, 2)
+	})
+
+	t.Run("duplicate columns", func(t *testing.T) {
+		t.Parallel()
+
+		events, err := ParseSheetIntoUnionSlice(cfg, "Type", types, WithDuplicateColumns(DuplicateColumnsLast),
+			withFetch(func(Config) (*sheets.ValueRange, error) {
+				return &sheets.ValueRange{
+					Values: [][]any{
+						{"Type", "ID", "Reason", "Reason"},
+						{"refund", "1", "lost", "damaged"},
+					},
+				}, nil
+			}),
+		)
+		require.NoError(t, err)
+		assert.Equal(t, []eventT{&refundEventT{ID: "1", Reason: "damaged"}}, events)
+	})
+}